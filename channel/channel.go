@@ -1,9 +1,10 @@
 package channel
 
 type Channel struct {
-	Number    int  `json:"number"`
-	FreqStart int  `json:"freqStart"`
-	FreqEnd   int  `json:"freqEnd"`
-	Indoors   bool `json:"indoors"`
-	Outdoors  bool `json:"outdoors"`
+	Number     int  `json:"number"`
+	FreqStart  int  `json:"freqStart"`
+	FreqEnd    int  `json:"freqEnd"`
+	Indoors    bool `json:"indoors"`
+	Outdoors   bool `json:"outdoors"`
+	Unverified bool `json:"unverified"`
 }
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	stringColumn = iota
+	intColumn
+	floatColumn
+	intListColumn
+)
+
+type column struct {
+	header string
+	field  string
+	kind   int
+}
+
+type dataset struct {
+	pkg      string
+	typeName string
+	varName  string
+	columns  []column
+}
+
+var datasets = map[string]dataset{
+	"AU": {
+		pkg:      "au",
+		typeName: "Transmitter",
+		varName:  "transmitters",
+		columns: []column{
+			{header: "name", field: "Name", kind: stringColumn},
+			{header: "zone", field: "Zone", kind: intColumn},
+			{header: "easting", field: "Easting", kind: floatColumn},
+			{header: "northing", field: "Northing", kind: floatColumn},
+			{header: "radius", field: "Radius", kind: floatColumn},
+			{header: "channels", field: "Channels", kind: intListColumn},
+		},
+	},
+	"BE": {
+		pkg:      "be",
+		typeName: "Transmitter",
+		varName:  "transmitters",
+		columns: []column{
+			{header: "name", field: "Name", kind: stringColumn},
+			{header: "easting", field: "Easting", kind: floatColumn},
+			{header: "northing", field: "Northing", kind: floatColumn},
+			{header: "radius", field: "Radius", kind: floatColumn},
+			{header: "channels", field: "Channels", kind: intListColumn},
+		},
+	},
+	"DE": {
+		pkg:      "de",
+		typeName: "Transmitter",
+		varName:  "transmitters",
+		columns: []column{
+			{header: "name", field: "Name", kind: stringColumn},
+			{header: "latitude", field: "Latitude", kind: floatColumn},
+			{header: "longitude", field: "Longitude", kind: floatColumn},
+			{header: "radius", field: "Radius", kind: floatColumn},
+			{header: "channels", field: "Channels", kind: intListColumn},
+		},
+	},
+	"FI": {
+		pkg:      "fi",
+		typeName: "Transmitter",
+		varName:  "transmitters",
+		columns: []column{
+			{header: "name", field: "Name", kind: stringColumn},
+			{header: "latitude", field: "Latitude", kind: floatColumn},
+			{header: "longitude", field: "Longitude", kind: floatColumn},
+			{header: "radius", field: "Radius", kind: floatColumn},
+			{header: "channels", field: "Channels", kind: intListColumn},
+		},
+	},
+	"FR": {
+		pkg:      "fr",
+		typeName: "Transmitter",
+		varName:  "transmitters",
+		columns: []column{
+			{header: "name", field: "Name", kind: stringColumn},
+			{header: "latitude", field: "Latitude", kind: floatColumn},
+			{header: "longitude", field: "Longitude", kind: floatColumn},
+			{header: "erp", field: "Erp", kind: floatColumn},
+			{header: "height", field: "Height", kind: floatColumn},
+			{header: "channels", field: "Channels", kind: intListColumn},
+		},
+	},
+}
+
+func main() {
+	country := flag.String("country", "", "country code of the dataset to import")
+	in := flag.String("in", "", "CSV file to import")
+	out := flag.String("out", "", "generated Go file to write (default stdout)")
+	version := flag.String("version", "", "dataset version to record")
+	flag.Parse()
+
+	d, ok := datasets[strings.ToUpper(*country)]
+	if !ok {
+		log.Fatalf("unsupported country %q", *country)
+	}
+	if *in == "" || *version == "" {
+		log.Fatal("-in and -version are required")
+	}
+
+	file, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	source, err := generate(d, file, *version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(source)
+		return
+	}
+	err = ioutil.WriteFile(*out, source, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(d dataset, r io.Reader, version string) ([]byte, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %s", err)
+	}
+	indices := make([]int, len(d.columns))
+	for i, c := range d.columns {
+		indices[i] = -1
+		for j, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), c.header) {
+				indices[i] = j
+			}
+		}
+		if indices[i] < 0 {
+			return nil, fmt.Errorf("missing column %q", c.header)
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by rfxp-import; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", d.pkg)
+	fmt.Fprintf(&b, "const DatasetVersion = %q\n\n", version)
+	fmt.Fprintf(&b, "var %s = []%s{\n", d.varName, d.typeName)
+
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+
+		fields := make([]string, len(d.columns))
+		for i, c := range d.columns {
+			value, err := formatValue(c, record[indices[i]])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: column %q: %s", line, c.header, err)
+			}
+			fields[i] = fmt.Sprintf("%s: %s", c.field, value)
+		}
+		fmt.Fprintf(&b, "{%s},\n", strings.Join(fields, ", "))
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	return format.Source(b.Bytes())
+}
+
+func formatValue(c column, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch c.kind {
+	case intColumn:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(i), nil
+	case floatColumn:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	case intListColumn:
+		values := []string{}
+		for _, v := range strings.Fields(value) {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, strconv.Itoa(i))
+		}
+		return fmt.Sprintf("[]int{%s}", strings.Join(values, ", ")), nil
+	default:
+		return strconv.Quote(value), nil
+	}
+}
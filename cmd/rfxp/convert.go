@@ -0,0 +1,393 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/stebunting/rfxp-backend/coordinates"
+)
+
+var errInvalidSystem = errors.New("invalid system")
+
+// eastingNorthingSystems are the grids NewFromEastingNorthing accepts.
+var eastingNorthingSystems = []string{
+	"GB", "OSTN15", "IE", "ITM", "RD", "NL", "SWEREF99TM",
+	"ETRS89UTM32", "ETRS89UTM33", "ED50UTM32", "BE", "BE08", "BE72",
+}
+
+const convertUsage = `usage: rfxp convert [flags] [LOCATION]
+
+Converts LOCATION, or each row of the -in CSV file, into every system named
+in -to.
+
+-from names the input system:
+  auto            any format accepted by coordinates.Parse, or a geohash
+  GB, OSTN15, IE, ITM, RD, SWEREF99TM, ETRS89UTM32, ETRS89UTM33, ED50UTM32,
+  BE08, BE72      easting and northing on the grid
+  UTM<zone><N|S>  UTM easting and northing, e.g. UTM33N
+  MGA<zone>       Map Grid of Australia easting and northing, e.g. MGA55
+  EPSG:<code>     x and y in a registered EPSG system
+  ECEF            earth-centred X, Y and Z in metres
+  MGRS, MAIDENHEAD, GEOHASH
+
+-to is a comma separated list of:
+  LATLON, DMS, DDM
+  GB, OSTN15, IE  lettered grid references
+  ITM, RD, SWEREF99TM, ETRS89UTM32, ETRS89UTM33, ED50UTM32, BE08, BE72
+                  easting and northing to the metre
+  UTM, UTM<zone>  UTM in the point's zone or a given zone
+  MGA, MGRS, MAIDENHEAD, GEOHASH, ECEF
+  EPSG:<code>     x and y in a registered EPSG system
+
+A LOCATION starting with a negative number, such as -0.1, -0.5, is read as
+the location rather than a flag. Flags end at the location, or at --.
+
+`
+
+func convert(args []string) error {
+	flags := flag.NewFlagSet("convert", flag.ExitOnError)
+	from := flags.String("from", "auto", "input system")
+	to := flags.String("to", "LATLON", "comma separated output systems")
+	in := flags.String("in", "", "CSV file to convert in bulk, or - for standard input")
+	columns := flags.String("columns", "", "comma separated CSV columns holding the location (default location, latitude,longitude or easting,northing)")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), convertUsage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(separateLocation(args))
+
+	targets := strings.Split(strings.ToUpper(*to), ",")
+	for i, target := range targets {
+		targets[i] = strings.TrimSpace(target)
+		if err := checkTarget(targets[i]); err != nil {
+			return err
+		}
+	}
+	system := strings.ToUpper(*from)
+
+	if *in == "" {
+		if flags.NArg() == 0 {
+			flags.Usage()
+			os.Exit(2)
+		}
+		return convertValue(os.Stdout, strings.Join(flags.Args(), " "), system, targets)
+	}
+
+	var r io.Reader = os.Stdin
+	if *in != "-" {
+		file, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+	return convertCSV(os.Stdout, r, *columns, system, targets)
+}
+
+// separateLocation ends the flags before a location that starts with a
+// negative number, which the flag package would otherwise take for a flag.
+// Every convert flag takes a value, so the argument after a flag without an
+// = is its value.
+func separateLocation(args []string) []string {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--" || !strings.HasPrefix(arg, "-"):
+			return args
+		case len(arg) > 1 && (arg[1] == '.' || (arg[1] >= '0' && arg[1] <= '9')):
+			separated := append([]string{}, args[:i]...)
+			return append(append(separated, "--"), args[i:]...)
+		case !strings.Contains(arg, "="):
+			i++
+		}
+	}
+	return args
+}
+
+func convertValue(w io.Writer, value string, system string, targets []string) error {
+	point, err := readPoint(value, system)
+	if err != nil {
+		return err
+	}
+
+	if len(targets) == 1 {
+		output, err := writePoint(&point, targets[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, output)
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, target := range targets {
+		output, err := writePoint(&point, target)
+		if err != nil {
+			output = "error: " + err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", target, output)
+	}
+	return tw.Flush()
+}
+
+// convertCSV copies a CSV file, appending a column for each target and an
+// error column so that one bad row does not stop the rest.
+func convertCSV(w io.Writer, r io.Reader, columns string, system string, targets []string) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	writer := csv.NewWriter(w)
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("reading header: %s", err)
+	}
+	indices, err := locationColumns(header, columns)
+	if err != nil {
+		return err
+	}
+	writer.Write(append(append(header, targets...), "error"))
+
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return fmt.Errorf("line %d: %s", line, err)
+		}
+
+		values := make([]string, len(indices))
+		for i, index := range indices {
+			values[i] = record[index]
+		}
+		outputs := make([]string, len(targets))
+		var rowErr error
+		point, err := readPoint(strings.Join(values, " "), system)
+		if err != nil {
+			rowErr = err
+		} else {
+			for i, target := range targets {
+				outputs[i], err = writePoint(&point, target)
+				if err != nil && rowErr == nil {
+					rowErr = fmt.Errorf("%s: %s", target, err)
+				}
+			}
+		}
+
+		message := ""
+		if rowErr != nil {
+			message = rowErr.Error()
+		}
+		writer.Write(append(append(record, outputs...), message))
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// locationColumns returns the indices of the named columns, or of the first
+// of the default sets of columns present in the header.
+func locationColumns(header []string, columns string) ([]int, error) {
+	candidates := [][]string{{"location"}, {"latitude", "longitude"}, {"easting", "northing"}}
+	if columns != "" {
+		candidates = [][]string{strings.Split(columns, ",")}
+	}
+
+	for _, names := range candidates {
+		indices := []int{}
+		for _, name := range names {
+			for i, h := range header {
+				if strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(name)) {
+					indices = append(indices, i)
+					break
+				}
+			}
+		}
+		if len(indices) == len(names) {
+			return indices, nil
+		}
+	}
+	if columns != "" {
+		return nil, fmt.Errorf("missing columns %q", columns)
+	}
+	return nil, errors.New("no location columns found, name them with -columns")
+}
+
+// readPoint parses a value in the input system.
+func readPoint(value string, system string) (coordinates.Point, error) {
+	switch {
+	case system == "AUTO":
+		return parseLocation(value)
+	case system == "MGRS":
+		return coordinates.ParseMGRS(value)
+	case system == "MAIDENHEAD":
+		return coordinates.ParseMaidenhead(value)
+	case system == "GEOHASH":
+		return coordinates.ParseGeohash(value)
+	}
+
+	numbers, err := readNumbers(value)
+	if err != nil {
+		return coordinates.Point{}, err
+	}
+	if system == "ECEF" {
+		if len(numbers) != 3 {
+			return coordinates.Point{}, errors.New("ECEF needs X, Y and Z")
+		}
+		return coordinates.NewFromECEF(numbers[0], numbers[1], numbers[2]), nil
+	}
+	if len(numbers) != 2 {
+		return coordinates.Point{}, fmt.Errorf("%s needs two numbers", system)
+	}
+	x, y := numbers[0], numbers[1]
+
+	switch {
+	case strings.HasPrefix(system, "EPSG:"):
+		code, err := strconv.Atoi(system[5:])
+		if err != nil {
+			return coordinates.Point{}, fmt.Errorf("%w %q", errInvalidSystem, system)
+		}
+		return coordinates.NewFromEPSG(code, x, y)
+	case strings.HasPrefix(system, "UTM") && len(system) > 4:
+		zone, err := strconv.Atoi(system[3 : len(system)-1])
+		hemisphere := system[len(system)-1]
+		if err != nil || zone < 1 || zone > 60 || (hemisphere != 'N' && hemisphere != 'S') {
+			return coordinates.Point{}, fmt.Errorf("%w %q", errInvalidSystem, system)
+		}
+		return coordinates.NewFromUTM(zone, hemisphere == 'N', x, y), nil
+	case strings.HasPrefix(system, "MGA"):
+		zone, err := strconv.Atoi(system[3:])
+		if err != nil || zone < 1 || zone > 60 {
+			return coordinates.Point{}, fmt.Errorf("%w %q", errInvalidSystem, system)
+		}
+		return coordinates.NewFromMGA(zone, x, y), nil
+	}
+	for _, s := range eastingNorthingSystems {
+		if s == system {
+			return coordinates.NewFromEastingNorthing(system, x, y)
+		}
+	}
+	return coordinates.Point{}, fmt.Errorf("%w %q", errInvalidSystem, system)
+}
+
+func readNumbers(value string) ([]float64, error) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == ',' || r == ';' || r == '\t'
+	})
+	numbers := make([]float64, len(fields))
+	for i, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", field)
+		}
+		numbers[i] = number
+	}
+	return numbers, nil
+}
+
+// checkTarget rejects unknown output systems before any input is read.
+func checkTarget(target string) error {
+	point := coordinates.New(0, 0)
+	_, err := writePoint(&point, target)
+	if errors.Is(err, errInvalidSystem) {
+		return err
+	}
+	return nil
+}
+
+// writePoint formats the point in the output system.
+func writePoint(point *coordinates.Point, target string) (string, error) {
+	switch target {
+	case "LATLON":
+		return fmt.Sprintf("%.6f, %.6f", point.GetLatitude(), point.GetLongitude()), nil
+	case "DMS":
+		return formatDMS(point.GetLatitude(), "N", "S") + " " + formatDMS(point.GetLongitude(), "E", "W"), nil
+	case "DDM":
+		return formatDDM(point.GetLatitude(), "N", "S") + " " + formatDDM(point.GetLongitude(), "E", "W"), nil
+	case "GB", "OSTN15", "IE":
+		gridReference, err := point.GetGridReference(target)
+		if err != nil {
+			return "", err
+		}
+		if gridReference.GetCode() == "" {
+			return "", errors.New("outside the grid")
+		}
+		return gridReference.Format(10, true)
+	case "UTM", "MGA":
+		gridReference, _ := point.GetGridReference(target)
+		zone := strconv.Itoa(gridReference.GetZone())
+		if target == "UTM" {
+			zone += gridReference.GetLatitudeBand()
+		}
+		return fmt.Sprintf("%s %.0f %.0f", zone, gridReference.GetEasting(), gridReference.GetNorthing()), nil
+	case "MGRS":
+		return point.GetMGRS()
+	case "MAIDENHEAD":
+		return point.GetMaidenhead(8)
+	case "GEOHASH":
+		return point.GetGeohash(9)
+	case "ECEF":
+		x, y, z := point.ToECEF()
+		return fmt.Sprintf("%.3f %.3f %.3f", x, y, z), nil
+	}
+
+	if strings.HasPrefix(target, "EPSG:") {
+		code, err := strconv.Atoi(target[5:])
+		if err != nil {
+			return "", fmt.Errorf("%w %q", errInvalidSystem, target)
+		}
+		x, y, err := point.GetEPSG(code)
+		if errors.Is(err, coordinates.ErrUnknownEPSG) {
+			return "", fmt.Errorf("%w %q", errInvalidSystem, target)
+		}
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%.3f %.3f", x, y), nil
+	}
+
+	gridReference, err := point.GetGridReference(target)
+	if errors.Is(err, coordinates.ErrInvalidSystem) {
+		return "", fmt.Errorf("%w %q", errInvalidSystem, target)
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%.0f %.0f", gridReference.GetEasting(), gridReference.GetNorthing()), nil
+}
+
+// formatDMS writes an angle as degrees, minutes and seconds to a hundredth
+// of a second, e.g. 59°19′45.48″N. Angles that round to zero take the
+// positive hemisphere.
+func formatDMS(angle float64, positive string, negative string) string {
+	hundredths := int(math.Round(math.Abs(angle) * 360000))
+	hemisphere := positive
+	if angle < 0 && hundredths > 0 {
+		hemisphere = negative
+	}
+	return fmt.Sprintf("%d°%02d′%05.2f″%s",
+		hundredths/360000, hundredths/6000%60, float64(hundredths%6000)/100, hemisphere)
+}
+
+// formatDDM writes an angle as degrees and decimal minutes to a
+// thousandth of a minute, e.g. N59 19.758. Angles that round to zero take
+// the positive hemisphere.
+func formatDDM(angle float64, positive string, negative string) string {
+	thousandths := int(math.Round(math.Abs(angle) * 60000))
+	hemisphere := positive
+	if angle < 0 && thousandths > 0 {
+		hemisphere = negative
+	}
+	return fmt.Sprintf("%s%d %06.3f", hemisphere, thousandths/60000, float64(thousandths%60000)/1000)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/coordinates"
+)
+
+func TestFormatDMS(t *testing.T) {
+	type TestCases struct {
+		Name  string
+		Angle float64
+		DMS   string
+		DDM   string
+	}
+	testCases := []TestCases{
+		{Name: "Stockholm", Angle: 59.3293, DMS: "59°19′45.48″N", DDM: "N59 19.758"},
+		{Name: "Seconds Carry", Angle: 59 + 59/60.0 + 59.995/3600, DMS: "60°00′00.00″N", DDM: "N60 00.000"},
+		{Name: "Minutes Carry", Angle: 18 + 59.9999/60, DMS: "18°59′59.99″N", DDM: "N19 00.000"},
+		{Name: "South", Angle: -33.856784, DMS: "33°51′24.42″S", DDM: "S33 51.407"},
+		{Name: "Negative Zero", Angle: negativeZero(), DMS: "0°00′00.00″N", DDM: "N0 00.000"},
+		{Name: "Rounds To Zero", Angle: -0.000000001, DMS: "0°00′00.00″N", DDM: "N0 00.000"},
+		{Name: "Just South", Angle: -0.00001, DMS: "0°00′00.04″S", DDM: "S0 00.001"},
+	}
+
+	for _, test := range testCases {
+		if dms := formatDMS(test.Angle, "N", "S"); dms != test.DMS {
+			t.Fatalf("\n--- Incorrect DMS ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, dms, test.DMS)
+		}
+		if ddm := formatDDM(test.Angle, "N", "S"); ddm != test.DDM {
+			t.Fatalf("\n--- Incorrect DDM ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, ddm, test.DDM)
+		}
+	}
+}
+
+func negativeZero() float64 {
+	zero := 0.0
+	return -zero
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	Threshold := 2.0 // metres, as outputs are rounded to the metre
+
+	type TestCases struct {
+		Name   string
+		Lat    float64
+		Lng    float64
+		System string
+		Input  string
+		Value  string
+	}
+	testCases := []TestCases{
+		{Name: "Wimbledon", Lat: 51.427617, Lng: -0.190801, System: "GB", Input: "AUTO", Value: "TQ 25875 71397"},
+		{Name: "Wimbledon", Lat: 51.427617, Lng: -0.190801, System: "LATLON", Input: "AUTO", Value: "51.427617, -0.190801"},
+		{Name: "Wimbledon", Lat: 51.427617, Lng: -0.190801, System: "DMS", Input: "AUTO"},
+		{Name: "Stockholm", Lat: 59.3293, Lng: 18.0686, System: "UTM", Input: "AUTO"},
+		{Name: "Stockholm", Lat: 59.3293, Lng: 18.0686, System: "UTM33", Input: "UTM33N"},
+		{Name: "Sydney", Lat: -33.856784, Lng: 151.215297, System: "UTM56", Input: "UTM56S"},
+		{Name: "Stockholm", Lat: 59.3293, Lng: 18.0686, System: "SWEREF99TM", Value: "674572 6580743"},
+		{Name: "Stockholm", Lat: 59.3293, Lng: 18.0686, System: "EPSG:3006", Value: "674571.866 6580743.008"},
+		{Name: "Amsterdam", Lat: 52.3676, Lng: 4.9041, System: "RD", Value: "122097 486745"},
+		{Name: "Amsterdam", Lat: 52.3676, Lng: 4.9041, System: "EPSG:28992"},
+		{Name: "Sydney", Lat: -33.856784, Lng: 151.215297, System: "ECEF"},
+		{Name: "Sydney", Lat: -33.856784, Lng: 151.215297, System: "MGRS"},
+	}
+
+	for _, test := range testCases {
+		point := coordinates.New(test.Lat, test.Lng)
+		output, err := writePoint(&point, test.System)
+		if err != nil {
+			t.Fatalf("Conversion unexpectedly errored: %s", err.Error())
+		}
+		if test.Value != "" && output != test.Value {
+			t.Fatalf("\n--- Incorrect Output ---\n    NAME: %s (%s)\n     GOT: %s\nEXPECTED: %s", test.Name, test.System, output, test.Value)
+		}
+
+		system := test.Input
+		if system == "" {
+			system = test.System
+		}
+		result, err := readPoint(output, system)
+		if err != nil {
+			t.Fatalf("Conversion unexpectedly errored: %s", err.Error())
+		}
+		if result.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Round Trip ---\n    NAME: %s (%s)\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, test.System, result.GetLatitude(), result.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+}
+
+func TestReadPoint(t *testing.T) {
+	Threshold := 2.0 // metres, as outputs are rounded to the metre
+
+	type TestCases struct {
+		Name   string
+		Value  string
+		System string
+		Lat    float64
+		Lng    float64
+	}
+	testCases := []TestCases{
+		{Name: "Decimal", Value: "-0.1, -0.5", System: "AUTO", Lat: -0.1, Lng: -0.5},
+		{Name: "Geohash", Value: "u6sce0t4h", System: "AUTO", Lat: 59.329283, Lng: 18.068583},
+		{Name: "UTM South", Value: "334901 6252289", System: "UTM56S", Lat: -33.856784, Lng: 151.215297},
+		{Name: "MGA", Value: "334901 6252289", System: "MGA56", Lat: -33.856784, Lng: 151.215297},
+		{Name: "WGS84", Value: "59.3293 18.0686", System: "EPSG:4326", Lat: 59.3293, Lng: 18.0686},
+		{Name: "MGRS", Value: "33V XF 74031 80807", System: "MGRS", Lat: 59.330097, Lng: 18.059160},
+	}
+
+	for _, test := range testCases {
+		point, err := readPoint(test.Value, test.System)
+		if err != nil {
+			t.Fatalf("Conversion unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Location ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	for _, test := range []struct{ Value, System string }{
+		{"1 2", "UTM61N"}, {"1 2", "UTM33X"}, {"1 2", "MGA0"}, {"1 2", "EPSG:1"},
+		{"1 2", "EPSG:abc"}, {"1 2", "FOO"}, {"1 2 3", "GB"}, {"1 2", "ECEF"}, {"one two", "GB"},
+	} {
+		if _, err := readPoint(test.Value, test.System); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n  SYSTEM: %s\n   VALUE: %s", test.System, test.Value)
+		}
+	}
+}
+
+func TestCheckTarget(t *testing.T) {
+	for _, target := range []string{"LATLON", "DMS", "DDM", "GB", "OSTN15", "IE", "RD", "UTM", "MGA", "MGRS", "MAIDENHEAD", "GEOHASH", "ECEF", "EPSG:3006", "EPSG:28992"} {
+		if err := checkTarget(target); err != nil {
+			t.Fatalf("Target %s unexpectedly errored: %s", target, err.Error())
+		}
+	}
+	for _, target := range []string{"FOO", "EPSG:1", "EPSG:abc", ""} {
+		if err := checkTarget(target); !errors.Is(err, errInvalidSystem) {
+			t.Fatalf("\n--- Expected Error ---\n  TARGET: %s", target)
+		}
+	}
+}
+
+func TestConvertCSV(t *testing.T) {
+	input := "name,latitude,longitude\n" +
+		"Wimbledon,51.427617,-0.190801\n" +
+		"Nowhere,91,0\n" +
+		"Stockholm,59.3293,18.0686\n" +
+		"Amsterdam,52.37,4.89\n"
+	expected := "name,latitude,longitude,GB,EPSG:28992,error\n" +
+		"Wimbledon,51.427617,-0.190801,TQ 25875 71397,,EPSG:28992: coordinates outside RD\n" +
+		"Nowhere,91,0,,,\"location \"\"91 0\"\": latitude must be between -90 and 90 degrees\"\n" +
+		"Stockholm,59.3293,18.0686,,,GB: outside the grid\n" +
+		"Amsterdam,52.37,4.89,,121138.147 487018.154,GB: outside the grid\n"
+
+	var output bytes.Buffer
+	if err := convertCSV(&output, strings.NewReader(input), "", "AUTO", []string{"GB", "EPSG:28992"}); err != nil {
+		t.Fatalf("Conversion unexpectedly errored: %s", err.Error())
+	}
+	if output.String() != expected {
+		t.Fatalf("\n--- Incorrect CSV ---\n     GOT: %s\nEXPECTED: %s", output.String(), expected)
+	}
+
+	if err := convertCSV(&output, strings.NewReader("name,x\nA,1\n"), "", "AUTO", []string{"GB"}); err == nil {
+		t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "Missing Columns")
+	}
+}
+
+func TestSeparateLocation(t *testing.T) {
+	type TestCases struct {
+		Args     []string
+		Expected []string
+	}
+	testCases := []TestCases{
+		{Args: []string{"-to", "DMS", "-0.1,", "-0.5"}, Expected: []string{"-to", "DMS", "--", "-0.1,", "-0.5"}},
+		{Args: []string{"-to", "DMS", "-0.1, -0.5"}, Expected: []string{"-to", "DMS", "--", "-0.1, -0.5"}},
+		{Args: []string{"-to", "DMS", "--", "-0.1"}, Expected: []string{"-to", "DMS", "--", "-0.1"}},
+		{Args: []string{"-to", "DMS", "59.3", "-18"}, Expected: []string{"-to", "DMS", "59.3", "-18"}},
+		{Args: []string{"-to=DMS", "-from", "UTM33N", "-5", "6"}, Expected: []string{"-to=DMS", "-from", "UTM33N", "--", "-5", "6"}},
+	}
+
+	for _, test := range testCases {
+		args := separateLocation(test.Args)
+		if strings.Join(args, " ") != strings.Join(test.Expected, " ") {
+			t.Fatalf("\n--- Incorrect Arguments ---\n     GOT: %q\nEXPECTED: %q", args, test.Expected)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/router"
+)
+
+func lookup(args []string) error {
+	flags := flag.NewFlagSet("lookup", flag.ExitOnError)
+	format := flags.String("format", "table", "output format: table, json or csv")
+	indoors := flags.Bool("indoors", false, "only list channels available indoors")
+	outdoors := flags.Bool("outdoors", false, "only list channels available outdoors")
+	height := flags.Float64("height", 0, "antenna height in metres")
+	heightReference := flags.String("height-reference", "ground", "what the height is measured from: ground or ellipsoid")
+	ostn15 := flags.Bool("ostn15", false, "use the OSTN15 transformation for the British National Grid")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: rfxp lookup [flags] COUNTRY LOCATION\n\n")
+		fmt.Fprintf(flags.Output(), "LOCATION is any format accepted by coordinates.Parse, or a geohash.\n\n")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() < 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if *heightReference != "ground" && *heightReference != "ellipsoid" {
+		return errors.New("-height-reference must be ground or ellipsoid")
+	}
+	var write func(io.Writer, router.Api, []channel.Channel) error
+	switch *format {
+	case "table":
+		write = writeTable
+	case "json":
+		write = writeJSON
+	case "csv":
+		write = writeCSV
+	default:
+		return errors.New("-format must be table, json or csv")
+	}
+
+	point, err := parseLocation(strings.Join(flags.Args()[1:], " "))
+	if err != nil {
+		return err
+	}
+
+	options := router.Options{OSTN15: *ostn15}
+	if *height != 0 {
+		options.Height = *height
+		options.HeightReference = *heightReference
+	}
+	api := router.NewApi(strings.ToUpper(flags.Arg(0)), point.GetLatitude(), point.GetLongitude(), options)
+	channels, err := api.Call()
+	if err != nil {
+		return fmt.Errorf("%s: %s", api.GetServiceName(), err)
+	}
+
+	return write(os.Stdout, api, filterChannels(*channels, *indoors, *outdoors))
+}
+
+// parseLocation accepts the formats of coordinates.Parse and, failing
+// those, a geohash.
+func parseLocation(location string) (coordinates.Point, error) {
+	point, err := coordinates.Parse(location)
+	if err == nil {
+		return point, nil
+	}
+	if point, geohashErr := coordinates.ParseGeohash(location); geohashErr == nil {
+		return point, nil
+	}
+	return coordinates.Point{}, fmt.Errorf("location %q: %s", location, err)
+}
+
+func filterChannels(channels []channel.Channel, indoors bool, outdoors bool) []channel.Channel {
+	filtered := []channel.Channel{}
+	for _, c := range channels {
+		if (indoors && !c.Indoors) || (outdoors && !c.Outdoors) {
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+	return filtered
+}
+
+func writeTable(w io.Writer, api router.Api, channels []channel.Channel) error {
+	fmt.Fprintf(w, "%s (%s)\n\n", api.GetCountryName(), api.GetServiceName())
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHANNEL\tSTART (MHz)\tEND (MHz)\tINDOORS\tOUTDOORS\tUNVERIFIED")
+	for _, c := range channels {
+		fmt.Fprintf(tw, "%d\t%.3f\t%.3f\t%s\t%s\t%s\n",
+			c.Number, float64(c.FreqStart)/1000, float64(c.FreqEnd)/1000,
+			yesNo(c.Indoors), yesNo(c.Outdoors), yesNo(c.Unverified))
+	}
+	return tw.Flush()
+}
+
+func writeJSON(w io.Writer, api router.Api, channels []channel.Channel) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(channels)
+}
+
+func writeCSV(w io.Writer, api router.Api, channels []channel.Channel) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"number", "freqStart", "freqEnd", "indoors", "outdoors", "unverified"})
+	for _, c := range channels {
+		writer.Write([]string{
+			strconv.Itoa(c.Number),
+			strconv.Itoa(c.FreqStart),
+			strconv.Itoa(c.FreqEnd),
+			strconv.FormatBool(c.Indoors),
+			strconv.FormatBool(c.Outdoors),
+			strconv.FormatBool(c.Unverified),
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/external/fallback"
+)
+
+var testChannels = []channel.Channel{
+	{Number: 37, FreqStart: 598000, FreqEnd: 606000, Indoors: true, Outdoors: true},
+	{Number: 38, FreqStart: 606000, FreqEnd: 614000, Indoors: false, Outdoors: false},
+	{Number: 39, FreqStart: 614000, FreqEnd: 622000, Indoors: true, Outdoors: false},
+	{Number: 0, FreqStart: 863000, FreqEnd: 865000, Indoors: true, Outdoors: true, Unverified: true},
+}
+
+func TestFilterChannels(t *testing.T) {
+	type TestCases struct {
+		Name     string
+		Indoors  bool
+		Outdoors bool
+		Expected []int
+	}
+	testCases := []TestCases{
+		{Name: "All", Expected: []int{598000, 606000, 614000, 863000}},
+		{Name: "Indoors", Indoors: true, Expected: []int{598000, 614000, 863000}},
+		{Name: "Outdoors", Outdoors: true, Expected: []int{598000, 863000}},
+		{Name: "Both", Indoors: true, Outdoors: true, Expected: []int{598000, 863000}},
+	}
+
+	for _, test := range testCases {
+		filtered := filterChannels(testChannels, test.Indoors, test.Outdoors)
+		got := []int{}
+		for _, c := range filtered {
+			got = append(got, c.FreqStart)
+		}
+		if len(got) != len(test.Expected) {
+			t.Fatalf("\n--- Incorrect Channels ---\n    NAME: %s\n     GOT: %v\nEXPECTED: %v", test.Name, got, test.Expected)
+		}
+		for i := range got {
+			if got[i] != test.Expected[i] {
+				t.Fatalf("\n--- Incorrect Channels ---\n    NAME: %s\n     GOT: %v\nEXPECTED: %v", test.Name, got, test.Expected)
+			}
+		}
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	Threshold := 2.0 // metres
+
+	type TestCases struct {
+		Name     string
+		Location string
+		Lat      float64
+		Lng      float64
+	}
+	testCases := []TestCases{
+		{Name: "Decimal", Location: "59.3293, 18.0686", Lat: 59.3293, Lng: 18.0686},
+		{Name: "Negative", Location: "-33.856784 151.215297", Lat: -33.856784, Lng: 151.215297},
+		{Name: "Geohash", Location: "u6sce0t4h", Lat: 59.329283, Lng: 18.068583},
+	}
+
+	for _, test := range testCases {
+		point, err := parseLocation(test.Location)
+		if err != nil {
+			t.Fatalf("Location unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Location ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	for _, location := range []string{"", "nowhere", "91 0", "0 181", "NaN 0", "59.3293"} {
+		if _, err := parseLocation(location); err == nil {
+			t.Fatalf("\n--- Expected Error ---\nLOCATION: %q", location)
+		}
+	}
+}
+
+func TestWriteChannels(t *testing.T) {
+	type TestCases struct {
+		Name     string
+		Write    func(w *bytes.Buffer) error
+		Expected string
+	}
+	api := &fallback.Fallback{Code: "AT"}
+	channels := testChannels[1:3]
+	testCases := []TestCases{
+		{
+			Name:  "Table",
+			Write: func(w *bytes.Buffer) error { return writeTable(w, api, channels) },
+			Expected: "Unknown (CEPT/ECC Harmonised Baseline)\n\n" +
+				"CHANNEL  START (MHz)  END (MHz)  INDOORS  OUTDOORS  UNVERIFIED\n" +
+				"38       606.000      614.000    no       no        no\n" +
+				"39       614.000      622.000    yes      no        no\n",
+		}, {
+			Name:  "JSON",
+			Write: func(w *bytes.Buffer) error { return writeJSON(w, api, channels) },
+			Expected: "[\n" +
+				"  {\n" +
+				"    \"number\": 38,\n" +
+				"    \"freqStart\": 606000,\n" +
+				"    \"freqEnd\": 614000,\n" +
+				"    \"indoors\": false,\n" +
+				"    \"outdoors\": false,\n" +
+				"    \"unverified\": false\n" +
+				"  },\n" +
+				"  {\n" +
+				"    \"number\": 39,\n" +
+				"    \"freqStart\": 614000,\n" +
+				"    \"freqEnd\": 622000,\n" +
+				"    \"indoors\": true,\n" +
+				"    \"outdoors\": false,\n" +
+				"    \"unverified\": false\n" +
+				"  }\n" +
+				"]\n",
+		}, {
+			Name:  "CSV",
+			Write: func(w *bytes.Buffer) error { return writeCSV(w, api, channels) },
+			Expected: "number,freqStart,freqEnd,indoors,outdoors,unverified\n" +
+				"38,606000,614000,false,false,false\n" +
+				"39,614000,622000,true,false,false\n",
+		},
+	}
+
+	for _, test := range testCases {
+		var output bytes.Buffer
+		if err := test.Write(&output); err != nil {
+			t.Fatalf("Write unexpectedly errored: %s", err.Error())
+		}
+		if output.String() != test.Expected {
+			t.Fatalf("\n--- Incorrect Output ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, output.String(), test.Expected)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Command rfxp runs whitespace lookups from the terminal, calling the
+// national providers directly rather than through the Lambda, and converts
+// locations between the coordinate systems regulators ask for.
+//
+//	rfxp lookup [flags] COUNTRY LOCATION
+//	rfxp convert [flags] [LOCATION]
+//
+// Run a command with -h for its flags.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{name: "lookup", summary: "list the channels available at a location", run: lookup},
+	{name: "convert", summary: "convert a location between coordinate systems", run: convert},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("rfxp: ")
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	for _, c := range commands {
+		if c.name == os.Args[1] {
+			if err := c.run(os.Args[2:]); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: rfxp COMMAND [flags] [arguments]\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", c.name, c.summary)
+	}
+}
@@ -8,7 +8,7 @@ type cartesian struct {
 	z float64
 }
 
-func NewCartesian(c *coordinates) cartesian {
+func NewCartesian(c *Point) cartesian {
 	phi := degreesToRadians(c.latitude)
 	lambda := degreesToRadians(c.longitude)
 	height := c.height
@@ -26,48 +26,105 @@ func NewCartesian(c *coordinates) cartesian {
 	}
 }
 
-func (s *cartesian) transform(datum datum) (float64, float64, float64) {
+// ToECEF returns the earth-centred, earth-fixed cartesian coordinates of the
+// point in metres.
+func (s *Point) ToECEF() (float64, float64, float64) {
+	c := NewCartesian(s)
+	return c.x, c.y, c.z
+}
+
+// NewFromECEF returns the Point at the given WGS84 earth-centred,
+// earth-fixed cartesian coordinates in metres.
+func NewFromECEF(x float64, y float64, z float64) Point {
+	c := cartesian{x: x, y: y, z: z}
+	latitude, longitude, height := c.toGeodetic(WGS84)
+	return NewWithHeight(latitude, longitude, height)
+}
+
+func (s *cartesian) transform(datum Datum) (float64, float64, float64) {
 	s.helmertTransformation(datum)
-	return s.toGeodetic(datum)
+	return s.toGeodetic(datum.ellipsoid)
+}
+
+// inverseTransform takes cartesian coordinates on the datum back to WGS84
+// latitude, longitude and height.
+func (s *cartesian) inverseTransform(datum Datum) (float64, float64, float64) {
+	s.inverseHelmertTransformation(datum)
+	return s.toGeodetic(WGS84)
 }
 
-func (s *cartesian) helmertTransformation(datum datum) {
-	cx := datum.helmertTransform[0]
-	cy := datum.helmertTransform[1]
-	cz := datum.helmertTransform[2]
+func (s *cartesian) helmertTransformation(datum Datum) {
+	cx, cy, cz, scaleFactor, rx, ry, rz := helmertParameters(datum)
 
-	scaleFactor := datum.helmertScale / 1000000
+	x := cx + scaleFactor*(s.x-rz*s.y+ry*s.z)
+	y := cy + scaleFactor*(rz*s.x+s.y-rx*s.z)
+	z := cz + scaleFactor*(-ry*s.x+rx*s.y+s.z)
+	s.x, s.y, s.z = x, y, z
+}
 
-	rx := secondsToRadians(datum.helmertRotation[0])
-	ry := secondsToRadians(datum.helmertRotation[1])
-	rz := secondsToRadians(datum.helmertRotation[2])
+// inverseHelmertTransformation reverses the transformation exactly by
+// solving it for the original coordinates, rather than by changing the sign
+// of every parameter, which leaves an error of around a centimetre.
+func (s *cartesian) inverseHelmertTransformation(datum Datum) {
+	cx, cy, cz, scaleFactor, rx, ry, rz := helmertParameters(datum)
+
+	u := (s.x - cx) / scaleFactor
+	v := (s.y - cy) / scaleFactor
+	w := (s.z - cz) / scaleFactor
+
+	// Cramer's rule on the rotation matrix
+	//	|  1  -rz  ry |
+	//	|  rz  1  -rx |
+	//	| -ry  rx  1  |
+	determinant := 1 + rx*rx + ry*ry + rz*rz
+	s.x = ((1+rx*rx)*u + (rz+rx*ry)*v + (rx*rz-ry)*w) / determinant
+	s.y = ((rx*ry-rz)*u + (1+ry*ry)*v + (rx+ry*rz)*w) / determinant
+	s.z = ((ry+rx*rz)*u + (ry*rz-rx)*v + (1+rz*rz)*w) / determinant
+}
 
-	s.x = cx + (1+scaleFactor)*(s.x-rz*s.y+ry*s.z)
-	s.y = cy + (1+scaleFactor)*(s.y+rz*s.x-rx*s.z)
-	s.z = cz + (1+scaleFactor)*(s.z-ry*s.x+rx*s.y)
+// helmertParameters returns the translation in metres, the scale as a
+// factor and the rotations in radians of the transformation from WGS84 to
+// the datum.
+func helmertParameters(datum Datum) (float64, float64, float64, float64, float64, float64, float64) {
+	return datum.helmertTransform[0],
+		datum.helmertTransform[1],
+		datum.helmertTransform[2],
+		1 + datum.helmertScale/1000000,
+		secondsToRadians(datum.helmertRotation[0]),
+		secondsToRadians(datum.helmertRotation[1]),
+		secondsToRadians(datum.helmertRotation[2])
 }
 
-func (s *cartesian) toGeodetic(datum datum) (float64, float64, float64) {
-	ellipsoid := datum.ellipsoid
+// toGeodetic returns the latitude, longitude and ellipsoidal height of the
+// cartesian coordinates on the ellipsoid, anywhere on the globe.
+func (s *cartesian) toGeodetic(ellipsoid Ellipsoid) (float64, float64, float64) {
 	a := ellipsoid.equatorialRadius
 	eSq := ellipsoid.eccentricitySquared
 
-	p := math.Sqrt(math.Pow(s.x, 2.0) + math.Pow(s.y, 2.0))
+	p := math.Hypot(s.x, s.y)
 
-	var oldPhi float64
-	var N float64
-	phi := math.Atan(s.z / (p * (1.0 - eSq)))
-	for {
-		oldPhi = phi
-		N := a / math.Sqrt(1.0-eSq*math.Pow(math.Sin(oldPhi), 2.0))
-		phi = math.Atan((s.z + (eSq * N * math.Sin(oldPhi))) / p)
-		if math.Abs(oldPhi-phi) < 0.000000000000000001 {
+	phi := math.Atan2(s.z, p*(1.0-eSq))
+	N := a
+	for i := 0; i < 20; i++ {
+		N = a / math.Sqrt(1.0-eSq*math.Pow(math.Sin(phi), 2.0))
+		oldPhi := phi
+		phi = math.Atan2(s.z+eSq*N*math.Sin(phi), p)
+		if math.Abs(oldPhi-phi) < 1e-15 {
 			break
 		}
 	}
+	N = a / math.Sqrt(1.0-eSq*math.Pow(math.Sin(phi), 2.0))
 
-	lambda := math.Atan(s.y / s.x)
-	H := (p / math.Cos(phi)) - N
+	lambda := math.Atan2(s.y, s.x)
+
+	// p/cos(phi) loses precision towards the poles, where the height is
+	// better taken from z.
+	var H float64
+	if math.Abs(phi) < math.Pi/4 {
+		H = p/math.Cos(phi) - N
+	} else {
+		H = s.z/math.Sin(phi) - N*(1.0-eSq)
+	}
 
 	return radiansToDegrees(phi), radiansToDegrees(lambda), H
 }
@@ -2,26 +2,57 @@ package coordinates
 
 import (
 	"errors"
+	"math"
+	"strconv"
 	"strings"
 )
 
-type coordinates struct {
+// ErrInvalidSystem is returned for a grid system name that is not known.
+var ErrInvalidSystem = errors.New("invalid system")
+
+// Point is a position given by latitude and longitude in decimal degrees on
+// the WGS84 ellipsoid.
+type Point struct {
 	latitude  float64
 	longitude float64
 	height    float64
-	ellipsoid ellipsoid
+	ellipsoid Ellipsoid
+}
+
+// New returns the Point at the given WGS84 latitude and longitude.
+func New(latitude float64, longitude float64) Point {
+	return NewWithHeight(latitude, longitude, 0)
 }
 
-func New(latitude float64, longitude float64) coordinates {
-	g := coordinates{
+// NewWithHeight returns the Point at the given WGS84 latitude, longitude and
+// height in metres above the ellipsoid.
+func NewWithHeight(latitude float64, longitude float64, height float64) Point {
+	g := Point{
 		latitude:  latitude,
 		longitude: longitude,
-		height:    0,
+		height:    height,
 		ellipsoid: WGS84,
 	}
 	return g
 }
 
+// Validate returns an error unless the point has a finite latitude between
+// -90 and 90 degrees and longitude between -180 and 180 degrees.
+func (s *Point) Validate() error {
+	if math.IsNaN(s.latitude) || math.IsNaN(s.longitude) || math.IsInf(s.latitude, 0) || math.IsInf(s.longitude, 0) {
+		return errors.New("coordinates must be finite")
+	}
+	if s.latitude < -90 || s.latitude > 90 {
+		return errors.New("latitude must be between -90 and 90 degrees")
+	}
+	if s.longitude < -180 || s.longitude > 180 {
+		return errors.New("longitude must be between -180 and 180 degrees")
+	}
+	return nil
+}
+
+// NewFromDegrees returns the Point at the given WGS84 latitude and longitude
+// in degrees, minutes and seconds. Directions are one of N, S, E or W.
 func NewFromDegrees(
 	latDegrees int,
 	latMinutes int,
@@ -31,41 +62,227 @@ func NewFromDegrees(
 	lngMinutes int,
 	lngSeconds float64,
 	lngDirection string,
-) (coordinates, error) {
+) (Point, error) {
 	latitude, err := toDecimal(latDegrees, latMinutes, latSeconds, latDirection)
 	if err != nil {
-		return coordinates{}, err
+		return Point{}, err
 	}
 	longitude, err := toDecimal(lngDegrees, lngMinutes, lngSeconds, lngDirection)
 	if err != nil {
-		return coordinates{}, err
+		return Point{}, err
 	}
 	return New(latitude, longitude), nil
 }
 
-func (s *coordinates) GetGridReference(system string) (gridReference, error) {
+// NewFromGridReference returns the Point referenced by a lettered grid
+// reference, as accepted by ParseGridReference.
+func NewFromGridReference(code string) (Point, error) {
+	gridReference, err := ParseGridReference(code)
+	if err != nil {
+		return Point{}, err
+	}
+	return gridReference.GetPoint(), nil
+}
+
+// NewFromEastingNorthing returns the Point at the given easting and northing
+// on one of the GB, OSTN15, IE, ITM, RD, SWEREF99TM, ETRS89UTM32,
+// ETRS89UTM33, BE72 or BE08 grid systems.
+func NewFromEastingNorthing(system string, easting float64, northing float64) (Point, error) {
+	var datum Datum
+	switch strings.ToUpper(system) {
+	case "GB":
+		datum = NationalGrid
+	case "OSTN15":
+		grid, err := getOSTN15()
+		if err != nil {
+			return Point{}, err
+		}
+		return grid.Inverse(easting, northing)
+	case "IE":
+		datum = IrishNationalGrid
+	case "ITM":
+		datum = IrishTransverseMercator
+	case "RD":
+		eastingNorthing := EastingNorthing{easting: easting, northing: northing}
+		latitude, longitude, err := eastingNorthing.rdToLatitudeLongitude()
+		if err != nil {
+			return Point{}, err
+		}
+		return New(latitude, longitude), nil
+	case "SWEREF99TM":
+		datum = Sweref99TM
+	case "ETRS89UTM32", "NL":
+		return newFromZone(EastingNorthing{easting: easting, northing: northing}, Etrs89Utm, 32), nil
+	case "ETRS89UTM33":
+		return newFromZone(EastingNorthing{easting: easting, northing: northing}, Etrs89Utm, 33), nil
+	case "ED50UTM32":
+		datum = Ed50Utm32
+	case "BE", "BE08":
+		datum = BelgianLambert08
+	case "BE72":
+		datum = BelgianLambert72
+	default:
+		return Point{}, ErrInvalidSystem
+	}
+	return inverseTransform(EastingNorthing{easting: easting, northing: northing}, datum), nil
+}
+
+// NewFromUTM returns the Point at the given UTM easting and northing.
+func NewFromUTM(zone int, northernHemisphere bool, easting float64, northing float64) Point {
+	datum := UtmNorth
+	if !northernHemisphere {
+		datum = UtmSouth
+	}
+	return newFromZone(EastingNorthing{easting: easting, northing: northing}, datum, zone)
+}
+
+// NewFromMGA returns the Point at the given Map Grid of Australia easting
+// and northing.
+func NewFromMGA(zone int, easting float64, northing float64) Point {
+	return newFromZone(EastingNorthing{easting: easting, northing: northing}, Mga, zone)
+}
+
+func newFromZone(eastingNorthing EastingNorthing, datum Datum, zone int) Point {
+	latitude, longitude := eastingNorthing.toLatitudeLongitude(datum)
+	return New(latitude, longitude-float64((30-zone)*6))
+}
+
+func inverseTransform(eastingNorthing EastingNorthing, datum Datum) Point {
+	var latitude, longitude float64
+	if datum.projection == LambertConformalConic {
+		latitude, longitude = eastingNorthing.lambertToLatitudeLongitude(datum)
+	} else {
+		latitude, longitude = eastingNorthing.toLatitudeLongitude(datum)
+	}
+
+	local := Point{
+		latitude:  latitude,
+		longitude: longitude,
+		ellipsoid: datum.ellipsoid,
+	}
+	cartesian := NewCartesian(&local)
+	latitude, longitude, _ = cartesian.inverseTransform(datum)
+	return New(latitude, longitude)
+}
+
+// GetLatitude returns the WGS84 latitude in decimal degrees.
+func (s *Point) GetLatitude() float64 {
+	return s.latitude
+}
+
+// GetLongitude returns the WGS84 longitude in decimal degrees.
+func (s *Point) GetLongitude() float64 {
+	return s.longitude
+}
+
+// GetHeight returns the height in metres above the WGS84 ellipsoid.
+func (s *Point) GetHeight() float64 {
+	return s.height
+}
+
+// GetGridReference projects the point onto the named grid system:
+//
+//	GB           Ordnance Survey National Grid
+//	OSTN15       Ordnance Survey National Grid by the OSTN15 grid shift,
+//	             or ErrOSTN15Unavailable without the OSTN15 data
+//	IE           Irish Grid
+//	ITM          Irish Transverse Mercator
+//	RD           Dutch RD New
+//	SWEREF99TM   Swedish SWEREF 99 TM
+//	ETRS89UTM32  ETRS89 UTM zone 32, used in Denmark (also NL)
+//	ETRS89UTM33  ETRS89 UTM zone 33, used in Norway
+//	ED50UTM32    ED50 UTM zone 32, used by the Dutch microphone map
+//	BE72         Belgian Lambert 72
+//	BE08         Belgian Lambert 2008 (also BE)
+//	UTM          UTM in the zone containing the point
+//	UTM1–UTM60   UTM in the given zone
+//	MGA          Map Grid of Australia in the zone containing the point
+func (s *Point) GetGridReference(system string) (GridReference, error) {
 	system = strings.ToUpper(system)
 	switch system {
 	case "GB":
 		return s.transform(NationalGrid, "GB"), nil
+	case "OSTN15":
+		grid, err := getOSTN15()
+		if err != nil {
+			return GridReference{}, err
+		}
+		return grid.Transform(s)
 	case "IE":
 		return s.transform(IrishNationalGrid, "IE"), nil
-	case "NL":
-		return s.getUTMWithZone(32), nil
+	case "ITM":
+		return s.transform(IrishTransverseMercator, "ITM"), nil
+	case "RD":
+		eastingNorthing, err := newRDEastingsNorthings(s.latitude, s.longitude)
+		if err != nil {
+			return GridReference{}, err
+		}
+		return NewGridReference(s, eastingNorthing, "RD", 1, true), nil
+	case "SWEREF99TM":
+		return s.transform(Sweref99TM, "SWEREF99TM"), nil
+	case "ETRS89UTM32", "NL":
+		return s.getETRS89UTMInZone(32), nil
+	case "ETRS89UTM33":
+		return s.getETRS89UTMInZone(33), nil
+	case "ED50UTM32":
+		return s.transform(Ed50Utm32, "ED50UTM32"), nil
+	case "BE", "BE08":
+		return s.transform(BelgianLambert08, "BE08"), nil
+	case "BE72":
+		return s.transform(BelgianLambert72, "BE72"), nil
 	case "UTM":
 		return s.GetUTM(), nil
+	case "MGA":
+		return s.GetMGAInZone(1 + (int)((s.longitude+180)/6)), nil
 	default:
-		return gridReference{}, errors.New("invalid system")
+		if strings.HasPrefix(system, "UTM") {
+			zone, err := strconv.Atoi(system[3:])
+			if err == nil && zone >= 1 && zone <= 60 {
+				return s.GetUTMInZone(zone), nil
+			}
+		}
+		return GridReference{}, ErrInvalidSystem
 	}
 }
 
-func (s *coordinates) GetUTM() gridReference {
-	return s.getUTMWithZone(1 + (int)((s.longitude+180)/6))
+// GetUTM returns the UTM grid reference in the zone containing the point.
+func (s *Point) GetUTM() GridReference {
+	return s.GetUTMInZone(utmZone(s.latitude, s.longitude))
 }
 
-func (s *coordinates) getUTMWithZone(zone int) gridReference {
+// utmZone returns the UTM zone containing a point, following the exceptions
+// that widen zone 32V over south west Norway and replace zones 32, 34 and 36
+// with wider zones 31, 33, 35 and 37 over Svalbard. Longitude 180 falls in
+// zone 60.
+func utmZone(latitude float64, longitude float64) int {
+	zone := 1 + int(math.Floor((longitude+180)/6))
+	if zone > 60 {
+		zone = 60
+	}
+
+	if latitude >= 56 && latitude < 64 && longitude >= 3 && longitude < 12 {
+		return 32
+	}
+	if latitude >= 72 && latitude <= 84 && longitude >= 0 && longitude < 42 {
+		switch {
+		case longitude < 9:
+			return 31
+		case longitude < 21:
+			return 33
+		case longitude < 33:
+			return 35
+		default:
+			return 37
+		}
+	}
+	return zone
+}
+
+// GetUTMInZone returns the UTM grid reference in the given zone, which may
+// differ from the zone the coordinates fall in.
+func (s *Point) GetUTMInZone(zone int) GridReference {
 	var northernHemisphere bool
-	var datum datum
+	var datum Datum
 	if s.latitude >= 0 {
 		northernHemisphere = true
 		datum = UtmNorth
@@ -79,10 +296,31 @@ func (s *coordinates) getUTMWithZone(zone int) gridReference {
 	return gridReference
 }
 
-func (s *coordinates) transform(datum datum, system string) gridReference {
+func (s *Point) getETRS89UTMInZone(zone int) GridReference {
+	eastingNorthing := NewEastingsNorthings(s.latitude, s.longitude+float64((30-zone)*6), Etrs89Utm)
+	gridReference := NewGridReference(s, eastingNorthing, "ETRS89UTM", zone, true)
+	return gridReference
+}
+
+// GetMGAInZone returns the Map Grid of Australia reference in the given zone,
+// which may differ from the zone the coordinates fall in.
+func (s *Point) GetMGAInZone(zone int) GridReference {
+	eastingNorthing := NewEastingsNorthings(s.latitude, s.longitude+float64((30-zone)*6), Mga)
+	gridReference := NewGridReference(s, eastingNorthing, "MGA", zone, false)
+	return gridReference
+}
+
+func (s *Point) transform(datum Datum, system string) GridReference {
+	gridReference := NewGridReference(s, s.project(datum), system, 1, true)
+	return gridReference
+}
+
+func (s *Point) project(datum Datum) EastingNorthing {
 	cartesian := NewCartesian(s)
 	lat, lon, _ := cartesian.transform(datum)
-	eastingNorthing := NewEastingsNorthings(lat, lon, datum)
-	gridReference := NewGridReference(s, eastingNorthing, system, 1, true)
-	return gridReference
+
+	if datum.projection == LambertConformalConic {
+		return newLambertEastingsNorthings(lat, lon, datum)
+	}
+	return NewEastingsNorthings(lat, lon, datum)
 }
@@ -1,6 +1,10 @@
 package coordinates_test
 
 import (
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/stebunting/rfxp-backend/coordinates"
@@ -448,3 +452,1499 @@ func TestWorldwideUtmGridReferenceFromDegrees(t *testing.T) {
 		}
 	}
 }
+
+func TestDistance(t *testing.T) {
+	Threshold := 100.0 // metres
+
+	type TestCases struct {
+		Name     string
+		Lat1     float64
+		Lng1     float64
+		Lat2     float64
+		Lng2     float64
+		Distance float64
+	}
+	testCases := []TestCases{
+		{
+			Name:     "London to Paris",
+			Lat1:     51.5074,
+			Lng1:     -0.1278,
+			Lat2:     48.8566,
+			Lng2:     2.3522,
+			Distance: 343556,
+		}, {
+			Name:     "Same Point",
+			Lat1:     59.3293,
+			Lng1:     18.0686,
+			Lat2:     59.3293,
+			Lng2:     18.0686,
+			Distance: 0,
+		},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat1, test.Lng1)
+		distance := lookup.DistanceTo(test.Lat2, test.Lng2)
+		if distance < test.Distance-Threshold || distance > test.Distance+Threshold {
+			t.Fatalf("\n--- Incorrect Distance ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, distance, test.Distance)
+		}
+	}
+}
+
+func TestBelgianLambertGridReference(t *testing.T) {
+	EastingsThreshold := 0.01  // metres
+	NorthingsThreshold := 0.01 // metres
+
+	lookup := coordinates.New(50.797815, 4.359215833333333)
+	gridReference, err := lookup.GetGridReference("BE08")
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	if gridReference.GetEasting() < 649328-EastingsThreshold || gridReference.GetEasting() > 649328+EastingsThreshold {
+		t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", "Lambert 2008 Origin", gridReference.GetEasting(), 649328.0)
+	}
+	if gridReference.GetNorthing() < 665262-NorthingsThreshold || gridReference.GetNorthing() > 665262+NorthingsThreshold {
+		t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", "Lambert 2008 Origin", gridReference.GetNorthing(), 665262.0)
+	}
+}
+
+func TestBelgianLambert72GridReference(t *testing.T) {
+	EastingsThreshold := 0.1  // metres
+	NorthingsThreshold := 0.1 // metres
+
+	type TestCases struct {
+		Name     string
+		Lat      float64
+		Lng      float64
+		Easting  float64
+		Northing float64
+	}
+	testCases := []TestCases{
+		{
+			Name:     "EPSG Guidance Note 7-2 Example", // 50°40'46.461"N 5°48'26.533"E on BD72
+			Lat:      50.679014286,
+			Lng:      5.808673869,
+			Easting:  251763.20,
+			Northing: 153034.13,
+		},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference, err := lookup.GetGridReference("BE72")
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if gridReference.GetEasting() < test.Easting-EastingsThreshold || gridReference.GetEasting() > test.Easting+EastingsThreshold {
+			t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetEasting(), test.Easting)
+		}
+		if gridReference.GetNorthing() < test.Northing-NorthingsThreshold || gridReference.GetNorthing() > test.Northing+NorthingsThreshold {
+			t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetNorthing(), test.Northing)
+		}
+	}
+}
+
+func TestMgaGridReference(t *testing.T) {
+	EastingsThreshold := 0.01  // metres
+	NorthingsThreshold := 0.01 // metres
+
+	type TestCases struct {
+		Name       string
+		LatDegrees int
+		LatMinutes int
+		LatSeconds float64
+		LngDegrees int
+		LngMinutes int
+		LngSeconds float64
+		Easting    float64
+		Northing   float64
+		Zone       int
+	}
+	testCases := []TestCases{
+		{
+			Name:       "Flinders Peak",
+			LatDegrees: 37,
+			LatMinutes: 57,
+			LatSeconds: 3.7203,
+			LngDegrees: 144,
+			LngMinutes: 25,
+			LngSeconds: 29.5244,
+			Easting:    273741.297,
+			Northing:   5796489.777,
+			Zone:       55,
+		},
+	}
+
+	for _, test := range testCases {
+		lookup, err := coordinates.NewFromDegrees(test.LatDegrees, test.LatMinutes, test.LatSeconds, "S", test.LngDegrees, test.LngMinutes, test.LngSeconds, "E")
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		gridReference, err := lookup.GetGridReference("MGA")
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if gridReference.GetEasting() < test.Easting-EastingsThreshold || gridReference.GetEasting() > test.Easting+EastingsThreshold {
+			t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetEasting(), test.Easting)
+		}
+		if gridReference.GetNorthing() < test.Northing-NorthingsThreshold || gridReference.GetNorthing() > test.Northing+NorthingsThreshold {
+			t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetNorthing(), test.Northing)
+		}
+		if gridReference.GetZone() != test.Zone {
+			t.Fatalf("\n--- Incorrect Zone ---\n    NAME: %s\n     GOT: %d\nEXPECTED: %d", test.Name, gridReference.GetZone(), test.Zone)
+		}
+	}
+}
+
+func TestExportedTypes(t *testing.T) {
+	points := map[string]coordinates.Point{
+		"Wimbledon": coordinates.New(51.42761719993095, -0.1908007959012176),
+	}
+	point := points["Wimbledon"]
+	if point.GetLatitude() != 51.42761719993095 || point.GetLongitude() != -0.1908007959012176 {
+		t.Fatalf("Point returned wrong latitude or longitude")
+	}
+
+	var gridReference coordinates.GridReference
+	gridReference, err := point.GetGridReference("GB")
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	if gridReference.GetGridSystem() != "GB" || !gridReference.IsNorthernHemisphere() {
+		t.Fatalf("GridReference returned wrong grid system")
+	}
+	if gridReference.GetLatitude() != point.GetLatitude() || gridReference.GetLongitude() != point.GetLongitude() {
+		t.Fatalf("GridReference returned wrong latitude or longitude")
+	}
+
+	var datum coordinates.Datum = coordinates.NationalGrid
+	var ellipsoid coordinates.Ellipsoid = datum.GetEllipsoid()
+	if datum.GetName() != "Ordnance Survey National Grid" || ellipsoid.GetName() != "Airy 1830" {
+		t.Fatalf("Datum returned wrong name or ellipsoid")
+	}
+	if ellipsoid.GetEquatorialRadius() != 6377563.396 || ellipsoid.GetPolarRadius() != 6356256.909 {
+		t.Fatalf("Ellipsoid returned wrong radii")
+	}
+}
+
+func TestParseGridReference(t *testing.T) {
+	Threshold := 0.00001 // degrees
+
+	type TestCases struct {
+		Name      string
+		Code      string
+		Lat       float64
+		Lng       float64
+		Easting   float64
+		Northing  float64
+		GridCode  string
+		GridShort string
+	}
+	testCases := []TestCases{
+		{
+			Name:      "Trafalgar Square",
+			Code:      "TQ 30155 80412",
+			Lat:       51.507658,
+			Lng:       -0.125970,
+			Easting:   530155,
+			Northing:  180412,
+			GridCode:  "TQ3015580412",
+			GridShort: "TQ301804",
+		}, {
+			Name:      "Trafalgar Square (6 figure)",
+			Code:      "tq301804",
+			Lat:       51.507562,
+			Lng:       -0.126767,
+			Easting:   530100,
+			Northing:  180400,
+			GridCode:  "TQ3010080400",
+			GridShort: "TQ301804",
+		}, {
+			Name:      "Belfast City Hall",
+			Code:      "IJ3382573948",
+			Lat:       54.596048,
+			Lng:       -5.930215,
+			Easting:   333825,
+			Northing:  373948,
+			GridCode:  "IJ3382573948",
+			GridShort: "IJ338739",
+		}, {
+			Name:      "Belfast City Hall (single letter)",
+			Code:      "J 33825 73948",
+			Lat:       54.596048,
+			Lng:       -5.930215,
+			Easting:   333825,
+			Northing:  373948,
+			GridCode:  "IJ3382573948",
+			GridShort: "IJ338739",
+		},
+	}
+
+	for _, test := range testCases {
+		gridReference, err := coordinates.ParseGridReference(test.Code)
+		if err != nil {
+			t.Fatalf("Grid reference unexpectedly errored: %s", err.Error())
+		}
+		if gridReference.GetEasting() != test.Easting {
+			t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetEasting(), test.Easting)
+		}
+		if gridReference.GetNorthing() != test.Northing {
+			t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetNorthing(), test.Northing)
+		}
+		if gridReference.GetLatitude() < test.Lat-Threshold || gridReference.GetLatitude() > test.Lat+Threshold {
+			t.Fatalf("\n--- Incorrect Latitude ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetLatitude(), test.Lat)
+		}
+		if gridReference.GetLongitude() < test.Lng-Threshold || gridReference.GetLongitude() > test.Lng+Threshold {
+			t.Fatalf("\n--- Incorrect Longitude ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetLongitude(), test.Lng)
+		}
+		if gridReference.GetCode() != test.GridCode {
+			t.Fatalf("\n--- Incorrect Code ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, gridReference.GetCode(), test.GridCode)
+		}
+		if gridReference.GetShortCode() != test.GridShort {
+			t.Fatalf("\n--- Incorrect ShortCode ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, gridReference.GetShortCode(), test.GridShort)
+		}
+	}
+
+	for _, code := range []string{"TQ 3015 804", "XX 30155 80412", "TQ 3015A 80412", "TQ 3015501 8041201"} {
+		_, err := coordinates.ParseGridReference(code)
+		if err == nil {
+			t.Fatalf("\n--- Expected Error ---\n    CODE: %s", code)
+		}
+	}
+}
+
+func TestInverseRoundTrip(t *testing.T) {
+	Threshold := 0.00000003 // degrees, a few millimetres
+
+	type TestCases struct {
+		Name   string
+		Lat    float64
+		Lng    float64
+		System string
+	}
+	testCases := []TestCases{
+		{Name: "The Lizard", Lat: 49.97454006765309, Lng: -5.212325001930045, System: "GB"},
+		{Name: "Wimbledon", Lat: 51.42761719993095, Lng: -0.1908007959012176, System: "GB"},
+		{Name: "Belfast", Lat: 54.597285, Lng: -5.930120, System: "IE"},
+		{Name: "Groningen", Lat: 53.21484, Lng: 6.569683, System: "UTM"},
+		{Name: "Brussels", Lat: 50.846557, Lng: 4.351697, System: "BE72"},
+		{Name: "Brussels", Lat: 50.846557, Lng: 4.351697, System: "BE08"},
+		{Name: "Amsterdam", Lat: 52.3676, Lng: 4.9041, System: "ED50UTM32"},
+		{Name: "Sydney", Lat: -33.856784, Lng: 151.215297, System: "MGA"},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference, err := lookup.GetGridReference(test.System)
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+
+		var point coordinates.Point
+		switch test.System {
+		case "UTM":
+			point = coordinates.NewFromUTM(gridReference.GetZone(), gridReference.IsNorthernHemisphere(), gridReference.GetEasting(), gridReference.GetNorthing())
+		case "MGA":
+			point = coordinates.NewFromMGA(gridReference.GetZone(), gridReference.GetEasting(), gridReference.GetNorthing())
+		default:
+			point, err = coordinates.NewFromEastingNorthing(test.System, gridReference.GetEasting(), gridReference.GetNorthing())
+			if err != nil {
+				t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+			}
+		}
+
+		if point.GetLatitude() < test.Lat-Threshold || point.GetLatitude() > test.Lat+Threshold {
+			t.Fatalf("\n--- Incorrect Latitude ---\n    NAME: %s (%s)\n     GOT: %f\nEXPECTED: %f", test.Name, test.System, point.GetLatitude(), test.Lat)
+		}
+		if point.GetLongitude() < test.Lng-Threshold || point.GetLongitude() > test.Lng+Threshold {
+			t.Fatalf("\n--- Incorrect Longitude ---\n    NAME: %s (%s)\n     GOT: %f\nEXPECTED: %f", test.Name, test.System, point.GetLongitude(), test.Lng)
+		}
+	}
+}
+
+func TestNationalGridETRS89(t *testing.T) {
+	Threshold := 0.001 // metres
+
+	// Caister Water Tower, from the OS guide to coordinate systems
+	lat := 52 + 39/60.0 + 28.8282/3600
+	lng := 1 + 42/60.0 + 57.8663/3600
+	eastingNorthing := coordinates.NewEastingsNorthings(lat, lng, coordinates.NationalGridETRS89)
+	if eastingNorthing.GetEasting() < 651307.003-Threshold || eastingNorthing.GetEasting() > 651307.003+Threshold {
+		t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", "Caister", eastingNorthing.GetEasting(), 651307.003)
+	}
+	if eastingNorthing.GetNorthing() < 313255.686-Threshold || eastingNorthing.GetNorthing() > 313255.686+Threshold {
+		t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", "Caister", eastingNorthing.GetNorthing(), 313255.686)
+	}
+}
+
+func TestOSTN15(t *testing.T) {
+	Threshold := 0.001 // metres
+
+	// The four nodes around Caister, with shifts chosen for the test
+	data := strings.Join([]string{
+		"Point_ID,ETRS89_Easting,ETRS89_Northing,ETRS89_OSGB36_EShift,ETRS89_OSGB36_NShift,ETRS89_ODN_HeightShift,Height_Datum_Flag",
+		"220065,651000,313000,102.787,-78.242,44.236,1",
+		"220066,652000,313000,102.818,-78.271,44.197,1",
+		"220767,652000,314000,102.810,-78.250,44.200,1",
+		"220766,651000,314000,102.776,-78.244,44.253,1",
+	}, "\n")
+	grid, err := coordinates.LoadOSTN15(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("OSTN15 unexpectedly errored: %s", err.Error())
+	}
+	coordinates.SetOSTN15(grid)
+	defer coordinates.SetOSTN15(nil)
+
+	lookup, _ := coordinates.NewFromDegrees(52, 39, 28.8282, "N", 1, 42, 57.8663, "E")
+	gridReference, err := lookup.GetGridReference("OSTN15")
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	if gridReference.GetEasting() < 651409.797-Threshold || gridReference.GetEasting() > 651409.797+Threshold {
+		t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", "Caister", gridReference.GetEasting(), 651409.797)
+	}
+	if gridReference.GetNorthing() < 313177.436-Threshold || gridReference.GetNorthing() > 313177.436+Threshold {
+		t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", "Caister", gridReference.GetNorthing(), 313177.436)
+	}
+	if gridReference.GetCode() != "TG5140913177" {
+		t.Fatalf("\n--- Incorrect Code ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", "Caister", gridReference.GetCode(), "TG5140913177")
+	}
+
+	point, err := coordinates.NewFromEastingNorthing("OSTN15", gridReference.GetEasting(), gridReference.GetNorthing())
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	if point.DistanceTo(lookup.GetLatitude(), lookup.GetLongitude()) > Threshold {
+		t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", "Caister", point.GetLatitude(), point.GetLongitude(), lookup.GetLatitude(), lookup.GetLongitude())
+	}
+
+	outside := coordinates.New(51.42761719993095, -0.1908007959012176)
+	_, err = outside.GetGridReference("OSTN15")
+	if err == nil {
+		t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "Wimbledon")
+	}
+}
+
+// TestOSTN15TestPoints checks test points published by OS. The data file is
+// not distributed with the package, so without it the test checks that
+// OSTN15 is refused rather than approximated; name the file in OSTN15_DATA
+// or build with -tags ostn15 to check the grid shift itself.
+func TestOSTN15TestPoints(t *testing.T) {
+	Threshold := 0.001 // metres
+
+	type TestCases struct {
+		Name     string
+		Lat      float64
+		Lng      float64
+		Easting  float64
+		Northing float64
+	}
+	testCases := []TestCases{
+		{
+			Name:     "Caister",
+			Lat:      52 + 39/60.0 + 28.8282/3600,
+			Lng:      1 + 42/60.0 + 57.8663/3600,
+			Easting:  651409.804,
+			Northing: 313177.450,
+		}, {
+			Name:     "TP01 St Mary's",
+			Lat:      49.92226393730,
+			Lng:      -6.29977752014,
+			Easting:  91492.146,
+			Northing: 11318.804,
+		},
+	}
+
+	lookup := coordinates.New(testCases[0].Lat, testCases[0].Lng)
+	if _, err := lookup.GetGridReference("OSTN15"); errors.Is(err, coordinates.ErrOSTN15Unavailable) {
+		if _, err := coordinates.NewFromEastingNorthing("OSTN15", testCases[0].Easting, testCases[0].Northing); !errors.Is(err, coordinates.ErrOSTN15Unavailable) {
+			t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "Inverse Without Data")
+		}
+		t.Skip("OSTN15 data not available")
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference, err := lookup.GetGridReference("OSTN15")
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if gridReference.GetGridSystem() != "OSTN15" {
+			t.Fatalf("\n--- Incorrect Grid System ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, gridReference.GetGridSystem(), "OSTN15")
+		}
+		if math.Abs(gridReference.GetEasting()-test.Easting) > Threshold {
+			t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetEasting(), test.Easting)
+		}
+		if math.Abs(gridReference.GetNorthing()-test.Northing) > Threshold {
+			t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetNorthing(), test.Northing)
+		}
+
+		point, err := coordinates.NewFromEastingNorthing("OSTN15", test.Easting, test.Northing)
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+}
+
+func TestItmGridReference(t *testing.T) {
+	Threshold := 0.001 // metres
+
+	type TestCases struct {
+		Name     string
+		Lat      float64
+		Lng      float64
+		Easting  float64
+		Northing float64
+	}
+	testCases := []TestCases{
+		{
+			Name:     "ITM Origin",
+			Lat:      53.5,
+			Lng:      -8,
+			Easting:  600000,
+			Northing: 750000,
+		}, {
+			Name:     "Dublin",
+			Lat:      53.349,
+			Lng:      -6.2603,
+			Easting:  715828.675,
+			Northing: 734608.586,
+		}, {
+			Name:     "Belfast",
+			Lat:      54.597285,
+			Lng:      -5.930120,
+			Easting:  733749.915,
+			Northing: 874081.901,
+		}, {
+			Name:     "Cork",
+			Lat:      51.8969,
+			Lng:      -8.4863,
+			Easting:  566531.331,
+			Northing: 571750.005,
+		}, {
+			Name:     "Belmullet",
+			Lat:      55.2,
+			Lng:      -10.3,
+			Easting:  453586.586,
+			Northing: 941609.118,
+		},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference, err := lookup.GetGridReference("ITM")
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if gridReference.GetEasting() < test.Easting-Threshold || gridReference.GetEasting() > test.Easting+Threshold {
+			t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetEasting(), test.Easting)
+		}
+		if gridReference.GetNorthing() < test.Northing-Threshold || gridReference.GetNorthing() > test.Northing+Threshold {
+			t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetNorthing(), test.Northing)
+		}
+
+		point, err := coordinates.NewFromEastingNorthing("ITM", test.Easting, test.Northing)
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+}
+
+func TestRdGridReference(t *testing.T) {
+	Threshold := 0.01 // metres
+
+	type TestCases struct {
+		Name     string
+		Lat      float64
+		Lng      float64
+		Easting  float64
+		Northing float64
+	}
+	testCases := []TestCases{
+		{
+			Name:     "Amersfoort",
+			Lat:      52.15517440,
+			Lng:      5.38720621,
+			Easting:  155000,
+			Northing: 463000,
+		}, {
+			Name:     "Amsterdam",
+			Lat:      52.373056,
+			Lng:      4.892778,
+			Easting:  121329.658,
+			Northing: 487356.880,
+		}, {
+			Name:     "Groningen",
+			Lat:      53.21484,
+			Lng:      6.569683,
+			Easting:  233990.446,
+			Northing: 581561.232,
+		}, {
+			Name:     "Maastricht",
+			Lat:      50.851368,
+			Lng:      5.690973,
+			Easting:  176392.925,
+			Northing: 317992.388,
+		}, {
+			Name:     "Vlissingen",
+			Lat:      51.442,
+			Lng:      3.573,
+			Easting:  28876.276,
+			Northing: 385224.819,
+		},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference, err := lookup.GetGridReference("RD")
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if gridReference.GetEasting() < test.Easting-Threshold || gridReference.GetEasting() > test.Easting+Threshold {
+			t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetEasting(), test.Easting)
+		}
+		if gridReference.GetNorthing() < test.Northing-Threshold || gridReference.GetNorthing() > test.Northing+Threshold {
+			t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetNorthing(), test.Northing)
+		}
+
+		point, err := coordinates.NewFromEastingNorthing("RD", test.Easting, test.Northing)
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	belfast := coordinates.New(54.5973, -5.9301)
+	if _, err := belfast.GetGridReference("RD"); err == nil {
+		t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "Belfast")
+	}
+	if _, _, err := belfast.GetEPSG(28992); err == nil {
+		t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "Belfast EPSG:28992")
+	}
+	if _, err := coordinates.NewFromEastingNorthing("RD", -574524, 792835); err == nil {
+		t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "Belfast Inverse")
+	}
+}
+
+func TestNLGridReference(t *testing.T) {
+	lookup := coordinates.New(52.3676, 4.9041)
+	expected, _ := lookup.GetGridReference("ETRS89UTM32")
+	gridReference, err := lookup.GetGridReference("NL")
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	if gridReference.GetGridSystem() != expected.GetGridSystem() {
+		t.Fatalf("\n--- Incorrect Grid System ---\n     GOT: %s\nEXPECTED: %s", gridReference.GetGridSystem(), expected.GetGridSystem())
+	}
+	if gridReference.GetEasting() != expected.GetEasting() || gridReference.GetNorthing() != expected.GetNorthing() {
+		t.Fatalf("\n--- Incorrect Easting Northing ---\n     GOT: %f, %f\nEXPECTED: %f, %f", gridReference.GetEasting(), gridReference.GetNorthing(), expected.GetEasting(), expected.GetNorthing())
+	}
+
+	point, err := coordinates.NewFromEastingNorthing("NL", expected.GetEasting(), expected.GetNorthing())
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	if point.DistanceTo(52.3676, 4.9041) > 0.001 {
+		t.Fatalf("\n--- Incorrect Inverse ---\n     GOT: %f, %f\nEXPECTED: %f, %f", point.GetLatitude(), point.GetLongitude(), 52.3676, 4.9041)
+	}
+
+	ed50, err := lookup.GetGridReference("ED50UTM32")
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	easting, northing, err := lookup.GetEPSG(23032)
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	if ed50.GetGridSystem() != "ED50UTM32" || ed50.GetEasting() != easting || ed50.GetNorthing() != northing {
+		t.Fatalf("\n--- Incorrect ED50 ---\n     GOT: %s %f, %f\nEXPECTED: ED50UTM32 %f, %f", ed50.GetGridSystem(), ed50.GetEasting(), ed50.GetNorthing(), easting, northing)
+	}
+}
+
+func TestNordicGridReference(t *testing.T) {
+	Threshold := 0.001 // metres
+
+	type TestCases struct {
+		Name     string
+		Lat      float64
+		Lng      float64
+		System   string
+		Easting  float64
+		Northing float64
+		Zone     int
+	}
+	testCases := []TestCases{
+		{
+			Name:     "Stockholm",
+			Lat:      59.3293,
+			Lng:      18.0686,
+			System:   "SWEREF99TM",
+			Easting:  674571.866,
+			Northing: 6580743.008,
+			Zone:     1,
+		}, {
+			Name:     "Malmö",
+			Lat:      55.605,
+			Lng:      13.0038,
+			System:   "SWEREF99TM",
+			Easting:  374243.759,
+			Northing: 6163926.553,
+			Zone:     1,
+		}, {
+			Name:     "Kiruna",
+			Lat:      67.8558,
+			Lng:      20.2253,
+			System:   "SWEREF99TM",
+			Easting:  719583.123,
+			Northing: 7536069.970,
+			Zone:     1,
+		}, {
+			Name:     "Oslo",
+			Lat:      59.9139,
+			Lng:      10.7522,
+			System:   "ETRS89UTM33",
+			Easting:  262560.482,
+			Northing: 6649443.584,
+			Zone:     33,
+		}, {
+			Name:     "Tromsø",
+			Lat:      69.6492,
+			Lng:      18.9553,
+			System:   "ETRS89UTM33",
+			Easting:  653421.188,
+			Northing: 7731721.083,
+			Zone:     33,
+		}, {
+			Name:     "Copenhagen",
+			Lat:      55.6761,
+			Lng:      12.5683,
+			System:   "ETRS89UTM32",
+			Easting:  724351.929,
+			Northing: 6175804.022,
+			Zone:     32,
+		}, {
+			Name:     "Aarhus",
+			Lat:      56.1629,
+			Lng:      10.2039,
+			System:   "ETRS89UTM32",
+			Easting:  574766.393,
+			Northing: 6224862.648,
+			Zone:     32,
+		},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference, err := lookup.GetGridReference(test.System)
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if gridReference.GetEasting() < test.Easting-Threshold || gridReference.GetEasting() > test.Easting+Threshold {
+			t.Fatalf("\n--- Incorrect Easting ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetEasting(), test.Easting)
+		}
+		if gridReference.GetNorthing() < test.Northing-Threshold || gridReference.GetNorthing() > test.Northing+Threshold {
+			t.Fatalf("\n--- Incorrect Northing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, gridReference.GetNorthing(), test.Northing)
+		}
+		if gridReference.GetZone() != test.Zone {
+			t.Fatalf("\n--- Incorrect Zone ---\n    NAME: %s\n     GOT: %d\nEXPECTED: %d", test.Name, gridReference.GetZone(), test.Zone)
+		}
+
+		point, err := coordinates.NewFromEastingNorthing(test.System, test.Easting, test.Northing)
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+}
+
+func TestMGRS(t *testing.T) {
+	Threshold := 1.5 // metres
+
+	type TestCases struct {
+		Name string
+		Lat  float64
+		Lng  float64
+		MGRS string
+		Band string
+	}
+	testCases := []TestCases{
+		{
+			Name: "Flinders Peak",
+			Lat:  -37.951033416,
+			Lng:  144.424867889,
+			MGRS: "55H BT 73741 96489",
+			Band: "H",
+		}, {
+			Name: "Greenwich",
+			Lat:  51.4778,
+			Lng:  -0.0014,
+			MGRS: "30U YC 08220 07224",
+			Band: "U",
+		}, {
+			Name: "Bergen",
+			Lat:  60.39,
+			Lng:  5.32,
+			MGRS: "32V KN 97230 00510",
+			Band: "V",
+		}, {
+			Name: "Longyearbyen",
+			Lat:  78.22,
+			Lng:  15.65,
+			MGRS: "33X WG 14813 83004",
+			Band: "X",
+		}, {
+			Name: "Gulf of Guinea",
+			Lat:  -0.5,
+			Lng:  -0.5,
+			MGRS: "30M YE 78265 44681",
+			Band: "M",
+		}, {
+			Name: "Antarctica",
+			Lat:  -79.9,
+			Lng:  100,
+			MGRS: "47C NM 19576 29407",
+			Band: "C",
+		},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		mgrs, err := lookup.GetMGRS()
+		if err != nil {
+			t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+		}
+		if mgrs != test.MGRS {
+			t.Fatalf("\n--- Incorrect MGRS ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, mgrs, test.MGRS)
+		}
+		if band := lookup.GetUTM(); band.GetLatitudeBand() != test.Band {
+			t.Fatalf("\n--- Incorrect Band ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, band.GetLatitudeBand(), test.Band)
+		}
+
+		point, err := coordinates.ParseMGRS(test.MGRS)
+		if err != nil {
+			t.Fatalf("MGRS unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	for name, lookup := range map[string]coordinates.Point{
+		"North Pole":    coordinates.New(85, 0),
+		"NaN Latitude":  coordinates.New(math.NaN(), 0),
+		"NaN Longitude": coordinates.New(0, math.NaN()),
+		"Inf Latitude":  coordinates.New(math.Inf(1), 0),
+	} {
+		if _, err := lookup.GetMGRS(); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n    NAME: %s", name)
+		}
+	}
+	for _, reference := range []string{
+		"61U DQ 48251 11932", "31I DQ 48251 11932", "31U DQ 4825 11932", "U DQ",
+		"1CAA", "31U SQ 48251 11932", "31X DQ 48251 11932",
+	} {
+		if _, err := coordinates.ParseMGRS(reference); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n    MGRS: %s", reference)
+		}
+	}
+}
+
+func TestUtmZones(t *testing.T) {
+	type TestCases struct {
+		Name string
+		Lat  float64
+		Lng  float64
+		Zone int
+	}
+	testCases := []TestCases{
+		{Name: "Bergen", Lat: 60.39, Lng: 5.32, Zone: 32},
+		{Name: "Stavanger", Lat: 58.97, Lng: 5.73, Zone: 32},
+		{Name: "Below 32V", Lat: 55.99, Lng: 5.32, Zone: 31},
+		{Name: "Above 32V", Lat: 64, Lng: 5.32, Zone: 31},
+		{Name: "West of 32V", Lat: 60, Lng: 2.99, Zone: 31},
+		{Name: "Oslo", Lat: 59.9139, Lng: 10.7522, Zone: 32},
+		{Name: "Longyearbyen", Lat: 78.22, Lng: 15.65, Zone: 33},
+		{Name: "Ny-Ålesund", Lat: 78.92, Lng: 11.93, Zone: 33},
+		{Name: "Svalbard 31X", Lat: 79, Lng: 8.99, Zone: 31},
+		{Name: "Svalbard 35X", Lat: 79, Lng: 21, Zone: 35},
+		{Name: "Svalbard 37X", Lat: 80, Lng: 33, Zone: 37},
+		{Name: "East of Svalbard", Lat: 80, Lng: 42, Zone: 38},
+		{Name: "Below Svalbard", Lat: 71.99, Lng: 8.99, Zone: 32},
+		{Name: "Greenwich", Lat: 51.4778, Lng: 0, Zone: 31},
+		{Name: "West of Greenwich", Lat: 51.4778, Lng: -0.000001, Zone: 30},
+		{Name: "Zone Edge", Lat: 45, Lng: 6, Zone: 32},
+		{Name: "Antimeridian East", Lat: -17, Lng: 180, Zone: 60},
+		{Name: "Antimeridian West", Lat: -17, Lng: -180, Zone: 1},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference := lookup.GetUTM()
+		if gridReference.GetZone() != test.Zone {
+			t.Fatalf("\n--- Incorrect Zone ---\n    NAME: %s\n     GOT: %d\nEXPECTED: %d", test.Name, gridReference.GetZone(), test.Zone)
+		}
+	}
+
+	lookup := coordinates.New(51.4778, -0.0014)
+	gridReference, err := lookup.GetGridReference("UTM32")
+	if err != nil {
+		t.Fatalf("Coordinates unexpectedly errored: %s", err.Error())
+	}
+	forced := lookup.GetUTMInZone(32)
+	if gridReference.GetZone() != 32 || gridReference.GetEasting() != forced.GetEasting() || gridReference.GetNorthing() != forced.GetNorthing() {
+		t.Fatalf("\n--- Incorrect Forced Zone ---\n    NAME: %s\n     GOT: %d %f %f\nEXPECTED: %d %f %f", "Greenwich", gridReference.GetZone(), gridReference.GetEasting(), gridReference.GetNorthing(), 32, forced.GetEasting(), forced.GetNorthing())
+	}
+	for _, system := range []string{"UTM0", "UTM61", "UTMX"} {
+		if _, err := lookup.GetGridReference(system); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n  SYSTEM: %s", system)
+		}
+	}
+}
+
+func TestECEF(t *testing.T) {
+	type TestCases struct {
+		Name   string
+		Lat    float64
+		Lng    float64
+		Height float64
+		X      float64
+		Y      float64
+		Z      float64
+	}
+	testCases := []TestCases{
+		{Name: "Null Island", Lat: 0, Lng: 0, Height: 0, X: 6378137, Y: 0, Z: 0},
+		{Name: "Antimeridian", Lat: 0, Lng: 180, Height: 100, X: -6378237, Y: 0, Z: 0},
+		{Name: "East", Lat: 0, Lng: 90, Height: 0, X: 0, Y: 6378137, Z: 0},
+		{Name: "West", Lat: 0, Lng: -90, Height: 0, X: 0, Y: -6378137, Z: 0},
+		{Name: "North Pole", Lat: 90, Lng: 0, Height: 0, X: 0, Y: 0, Z: 6356752.314245},
+		{Name: "South Pole", Lat: -90, Lng: 0, Height: 1000, X: 0, Y: 0, Z: -6357752.314245},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.NewWithHeight(test.Lat, test.Lng, test.Height)
+		x, y, z := lookup.ToECEF()
+		if math.Abs(x-test.X) > 0.001 || math.Abs(y-test.Y) > 0.001 || math.Abs(z-test.Z) > 0.001 {
+			t.Fatalf("\n--- Incorrect ECEF ---\n    NAME: %s\n     GOT: %f, %f, %f\nEXPECTED: %f, %f, %f", test.Name, x, y, z, test.X, test.Y, test.Z)
+		}
+
+		point := coordinates.NewFromECEF(test.X, test.Y, test.Z)
+		if math.Abs(point.GetLatitude()-test.Lat) > 1e-9 || math.Abs(point.GetHeight()-test.Height) > 0.001 {
+			t.Fatalf("\n--- Incorrect Geodetic ---\n    NAME: %s\n     GOT: %f, %f, %f\nEXPECTED: %f, %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), point.GetHeight(), test.Lat, test.Lng, test.Height)
+		}
+		if math.Abs(test.Lat) != 90 && math.Abs(point.GetLongitude()-test.Lng) > 1e-9 {
+			t.Fatalf("\n--- Incorrect Longitude ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, point.GetLongitude(), test.Lng)
+		}
+	}
+}
+
+func TestECEFRoundTrip(t *testing.T) {
+	AngleThreshold := 1e-9    // degrees
+	HeightThreshold := 0.0001 // metres
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		lat := random.Float64()*180 - 90
+		lng := random.Float64()*360 - 180
+		height := random.Float64()*20000 - 1000
+
+		lookup := coordinates.NewWithHeight(lat, lng, height)
+		point := coordinates.NewFromECEF(lookup.ToECEF())
+
+		dLng := math.Mod(point.GetLongitude()-lng+540, 360) - 180
+		if math.Abs(point.GetLatitude()-lat) > AngleThreshold || math.Abs(dLng) > AngleThreshold {
+			t.Fatalf("\n--- Incorrect Round Trip ---\n     GOT: %.10f, %.10f\nEXPECTED: %.10f, %.10f", point.GetLatitude(), point.GetLongitude(), lat, lng)
+		}
+		if math.Abs(point.GetHeight()-height) > HeightThreshold {
+			t.Fatalf("\n--- Incorrect Height ---\n    LOCATION: %f, %f\n     GOT: %f\nEXPECTED: %f", lat, lng, point.GetHeight(), height)
+		}
+	}
+}
+
+func TestInverseRoundTripWorldwide(t *testing.T) {
+	Threshold := 0.001 // metres
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		lat := random.Float64()*164 - 80
+		lng := random.Float64()*360 - 180
+
+		lookup := coordinates.New(lat, lng)
+		gridReference := lookup.GetUTM()
+		point := coordinates.NewFromUTM(gridReference.GetZone(), gridReference.IsNorthernHemisphere(), gridReference.GetEasting(), gridReference.GetNorthing())
+		if distance := point.DistanceTo(lat, lng); distance > Threshold {
+			t.Fatalf("\n--- Incorrect Round Trip ---\n    LOCATION: %f, %f\n     GOT: %f, %f\nDISTANCE: %f", lat, lng, point.GetLatitude(), point.GetLongitude(), distance)
+		}
+	}
+}
+
+func TestFormatGridReference(t *testing.T) {
+	type TestCases struct {
+		Name   string
+		Code   string
+		Digits int
+		Spaces bool
+		Result string
+	}
+	testCases := []TestCases{
+		{Name: "2 Digits", Code: "TQ 301551 804123", Digits: 2, Spaces: true, Result: "TQ 3 8"},
+		{Name: "4 Digits", Code: "TQ 301551 804123", Digits: 4, Spaces: false, Result: "TQ3080"},
+		{Name: "6 Digits", Code: "TQ 301551 804123", Digits: 6, Spaces: true, Result: "TQ 301 804"},
+		{Name: "8 Digits", Code: "TQ 301551 804123", Digits: 8, Spaces: true, Result: "TQ 3015 8041"},
+		{Name: "10 Digits", Code: "TQ 301551 804123", Digits: 10, Spaces: false, Result: "TQ3015580412"},
+		{Name: "12 Digits", Code: "TQ 301551 804123", Digits: 12, Spaces: true, Result: "TQ 301551 804123"},
+		{Name: "Irish", Code: "J 33825 73948", Digits: 8, Spaces: true, Result: "IJ 3382 7394"},
+		{Name: "Leading Zeros", Code: "SV 0123 0045", Digits: 10, Spaces: true, Result: "SV 01230 00450"},
+		{Name: "Channel Islands", Code: "CJ 58000 44000", Digits: 6, Spaces: true, Result: "CJ 580 440"},
+		{Name: "Invalid Square", Code: "WV 58000 44000", Digits: 6, Spaces: true, Result: ""},
+	}
+
+	for _, test := range testCases {
+		gridReference, err := coordinates.ParseGridReference(test.Code)
+		if test.Result == "" {
+			if err == nil {
+				t.Fatalf("\n--- Expected Error ---\n    NAME: %s", test.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Grid reference unexpectedly errored: %s", err.Error())
+		}
+		result, err := gridReference.Format(test.Digits, test.Spaces)
+		if err != nil {
+			t.Fatalf("Format unexpectedly errored: %s", err.Error())
+		}
+		if result != test.Result {
+			t.Fatalf("\n--- Incorrect Format ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, result, test.Result)
+		}
+
+		// The formatted reference parses back to the same square
+		parsed, err := coordinates.ParseGridReference(result)
+		if err != nil {
+			t.Fatalf("Grid reference unexpectedly errored: %s", err.Error())
+		}
+		reformatted, _ := parsed.Format(test.Digits, test.Spaces)
+		if reformatted != result {
+			t.Fatalf("\n--- Incorrect Round Trip ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, reformatted, result)
+		}
+	}
+
+	gridReference, _ := coordinates.ParseGridReference("TQ 30155 80412")
+	for _, digits := range []int{0, 3, 14} {
+		if _, err := gridReference.Format(digits, false); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n  DIGITS: %d", digits)
+		}
+	}
+	lookup := coordinates.New(53.21484, 6.569683)
+	utm := lookup.GetUTM()
+	if _, err := utm.Format(10, false); err == nil {
+		t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "Groningen UTM")
+	}
+}
+
+func TestGridSquareLetters(t *testing.T) {
+	type TestCases struct {
+		Name    string
+		Squares []string // north to south, west to east
+	}
+	testCases := []TestCases{
+		{
+			Name: "GB",
+			Squares: []string{
+				"HL HM HN HO HP JL JM JN",
+				"HQ HR HS HT HU JQ JR JS",
+				"HV HW HX HY HZ JV JW JX",
+				"NA NB NC ND NE OA OB OC",
+				"NF NG NH NJ NK OF OG OH",
+				"NL NM NN NO NP OL OM ON",
+				"NQ NR NS NT NU OQ OR OS",
+				"NV NW NX NY NZ OV OW OX",
+				"SA SB SC SD SE TA TB TC",
+				"SF SG SH SJ SK TF TG TH",
+				"SL SM SN SO SP TL TM TN",
+				"SQ SR SS ST SU TQ TR TS",
+				"SV SW SX SY SZ TV TW TX",
+			},
+		}, {
+			Name: "IE",
+			Squares: []string{
+				"IA IB IC ID IE",
+				"IF IG IH IJ IK",
+				"IL IM IN IO IP",
+				"IQ IR IS IT IU",
+				"IV IW IX IY IZ",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		for i, row := range test.Squares {
+			n := len(test.Squares) - 1 - i
+			for e, letters := range strings.Fields(row) {
+				gridReference, err := coordinates.ParseGridReference(letters + "5000050000")
+				if err != nil {
+					t.Fatalf("Grid reference unexpectedly errored: %s", err.Error())
+				}
+				if gridReference.GetGridSystem() != test.Name {
+					t.Fatalf("\n--- Incorrect Grid System ---\n  SQUARE: %s\n     GOT: %s\nEXPECTED: %s", letters, gridReference.GetGridSystem(), test.Name)
+				}
+				if gridReference.GetEasting() != float64(e*100000+50000) || gridReference.GetNorthing() != float64(n*100000+50000) {
+					t.Fatalf("\n--- Incorrect Square ---\n  SQUARE: %s\n     GOT: %f, %f\nEXPECTED: %d, %d", letters, gridReference.GetEasting(), gridReference.GetNorthing(), e*100000+50000, n*100000+50000)
+				}
+				if gridReference.GetCode() != letters+"5000050000" {
+					t.Fatalf("\n--- Incorrect Code ---\n  SQUARE: %s\n     GOT: %s\nEXPECTED: %s", letters, gridReference.GetCode(), letters+"5000050000")
+				}
+			}
+		}
+	}
+
+	for _, letters := range []string{"HK", "SI", "IIA", "JO", "TY", "AA"} {
+		if _, err := coordinates.ParseGridReference(letters + "5000050000"); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n  SQUARE: %s", letters)
+		}
+	}
+
+	lookup := coordinates.New(50, -10)
+	gridReference, _ := lookup.GetGridReference("GB")
+	if gridReference.GetCode() != "" {
+		t.Fatalf("\n--- Incorrect Code ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", "West of the National Grid", gridReference.GetCode(), "")
+	}
+}
+
+func TestChannelIslandSquares(t *testing.T) {
+	type TestCases struct {
+		Name   string
+		Lat    float64
+		Lng    float64
+		Square string
+	}
+	testCases := []TestCases{
+		{Name: "Minquiers", Lat: 48.97, Lng: -2.13, Square: "CJ"},
+		{Name: "Les Écréhous", Lat: 49.29, Lng: -1.93, Square: "CJ"},
+		{Name: "Sark", Lat: 49.43, Lng: -2.36, Square: "CJ"},
+		{Name: "Herm", Lat: 49.47, Lng: -2.45, Square: "CJ"},
+		{Name: "Lihou", Lat: 49.46, Lng: -2.67, Square: "CJ"},
+		{Name: "Braye, Alderney", Lat: 49.7236, Lng: -2.2075, Square: "CA"},
+		{Name: "Burhou", Lat: 49.73, Lng: -2.26, Square: "CA"},
+		{Name: "Casquets", Lat: 49.72, Lng: -2.38, Square: "CA"},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference := lookup.GetUTM()
+		if !strings.HasPrefix(gridReference.GetCode(), test.Square) {
+			t.Fatalf("\n--- Incorrect Square ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, gridReference.GetCode(), test.Square)
+		}
+
+		parsed, err := coordinates.ParseGridReference(gridReference.GetCode())
+		if err != nil {
+			t.Fatalf("Grid reference unexpectedly errored: %s", err.Error())
+		}
+		point := parsed.GetPoint()
+		if point.DistanceTo(test.Lat, test.Lng) > 1.5 {
+			t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+}
+
+func TestGeodesic(t *testing.T) {
+	DistanceThreshold := 0.001 // metres
+	BearingThreshold := 1e-5   // degrees
+
+	type TestCases struct {
+		Name           string
+		Ellipsoid      coordinates.Ellipsoid
+		Lat1           float64
+		Lng1           float64
+		Lat2           float64
+		Lng2           float64
+		Distance       float64
+		InitialBearing float64
+		FinalBearing   float64
+	}
+	testCases := []TestCases{
+		{
+			Name:           "Flinders Peak to Buninyong",
+			Ellipsoid:      coordinates.GRS80,
+			Lat1:           -37.95103341666667,
+			Lng1:           144.42486788888888,
+			Lat2:           -37.65282113888889,
+			Lng2:           143.92649552777777,
+			Distance:       54972.271,
+			InitialBearing: 306.8681583333333,
+			FinalBearing:   307.1736305555556,
+		}, {
+			Name:           "Vincenty Line B",
+			Ellipsoid:      coordinates.International1924,
+			Lat1:           37.331931575000006,
+			Lng1:           0,
+			Lat2:           26.128566516666666,
+			Lng2:           41.47652980277778,
+			Distance:       4085966.703,
+			InitialBearing: 95.46656413611112,
+			FinalBearing:   118.09971155833333,
+		}, {
+			Name:           "Vincenty Line C",
+			Ellipsoid:      coordinates.International1924,
+			Lat1:           35.26979128333333,
+			Lng1:           0,
+			Lat2:           67.37077121666665,
+			Lng2:           137.79119843055557,
+			Distance:       8084823.839,
+			InitialBearing: 15.739930138888887,
+			FinalBearing:   144.92775596388887,
+		},
+	}
+
+	for _, test := range testCases {
+		distance, initialBearing, finalBearing, err := test.Ellipsoid.Inverse(test.Lat1, test.Lng1, test.Lat2, test.Lng2)
+		if err != nil {
+			t.Fatalf("Geodesic unexpectedly errored: %s", err.Error())
+		}
+		if math.Abs(distance-test.Distance) > DistanceThreshold {
+			t.Fatalf("\n--- Incorrect Distance ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, distance, test.Distance)
+		}
+		if math.Abs(initialBearing-test.InitialBearing) > BearingThreshold || math.Abs(finalBearing-test.FinalBearing) > BearingThreshold {
+			t.Fatalf("\n--- Incorrect Bearings ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, initialBearing, finalBearing, test.InitialBearing, test.FinalBearing)
+		}
+
+		latitude, longitude, finalBearing := test.Ellipsoid.Direct(test.Lat1, test.Lng1, test.InitialBearing, test.Distance)
+		if math.Abs(latitude-test.Lat2) > 1e-7 || math.Abs(longitude-test.Lng2) > 1e-7 {
+			t.Fatalf("\n--- Incorrect Destination ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, latitude, longitude, test.Lat2, test.Lng2)
+		}
+		if math.Abs(finalBearing-test.FinalBearing) > BearingThreshold {
+			t.Fatalf("\n--- Incorrect Final Bearing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, finalBearing, test.FinalBearing)
+		}
+	}
+
+	if _, _, _, err := coordinates.WGS84.Inverse(0, 0, 0.5, 179.7); err == nil {
+		t.Fatalf("Expected nearly antipodal geodesic to error")
+	}
+
+	lookup := coordinates.New(59.3293, 18.0686)
+	distance, bearing, err := lookup.GeodesicTo(59.3293, 18.0686)
+	if err != nil || distance != 0 || bearing != 0 {
+		t.Fatalf("\n--- Incorrect Geodesic ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", "Same Point", distance, bearing, 0.0, 0.0)
+	}
+}
+
+func TestBearingAndDestination(t *testing.T) {
+	Threshold := 0.01 // metres
+
+	type TestCases struct {
+		Name    string
+		Lat1    float64
+		Lng1    float64
+		Lat2    float64
+		Lng2    float64
+		Bearing float64
+	}
+	testCases := []TestCases{
+		{Name: "London to Paris", Lat1: 51.5074, Lng1: -0.1278, Lat2: 48.8566, Lng2: 2.3522, Bearing: 148.1156},
+		{Name: "Due North", Lat1: 0, Lng1: 0, Lat2: 1, Lng2: 0, Bearing: 0},
+		{Name: "Due West", Lat1: 0, Lng1: 10, Lat2: 0, Lng2: 9, Bearing: 270},
+		{Name: "Across Antimeridian", Lat1: 0, Lng1: 179.5, Lat2: 0, Lng2: -179.5, Bearing: 90},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat1, test.Lng1)
+		bearing := lookup.BearingTo(test.Lat2, test.Lng2)
+		if math.Abs(bearing-test.Bearing) > 0.0001 {
+			t.Fatalf("\n--- Incorrect Bearing ---\n    NAME: %s\n     GOT: %f\nEXPECTED: %f", test.Name, bearing, test.Bearing)
+		}
+
+		destination := lookup.Destination(bearing, lookup.DistanceTo(test.Lat2, test.Lng2))
+		if destination.DistanceTo(test.Lat2, test.Lng2) > Threshold {
+			t.Fatalf("\n--- Incorrect Destination ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, destination.GetLatitude(), destination.GetLongitude(), test.Lat2, test.Lng2)
+		}
+
+		distance, geodesicBearing, err := lookup.GeodesicTo(test.Lat2, test.Lng2)
+		if err != nil {
+			t.Fatalf("Geodesic unexpectedly errored: %s", err.Error())
+		}
+		geodesicDestination := lookup.GeodesicDestination(geodesicBearing, distance)
+		if geodesicDestination.DistanceTo(test.Lat2, test.Lng2) > Threshold {
+			t.Fatalf("\n--- Incorrect Geodesic Destination ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, geodesicDestination.GetLatitude(), geodesicDestination.GetLongitude(), test.Lat2, test.Lng2)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	Threshold := 1.0 // metres
+
+	type TestCases struct {
+		Name     string
+		Location string
+		Lat      float64
+		Lng      float64
+	}
+	testCases := []TestCases{
+		{Name: "Decimal", Location: "59.3293, 18.0686", Lat: 59.3293, Lng: 18.0686},
+		{Name: "Decimal Without Comma", Location: "-33.8568 151.2153", Lat: -33.8568, Lng: 151.2153},
+		{Name: "Decimal With Hemispheres", Location: "59.3293° N, 18.0686° E", Lat: 59.3293, Lng: 18.0686},
+		{Name: "Longitude First", Location: "18.0686E 59.3293N", Lat: 59.3293, Lng: 18.0686},
+		{Name: "DMS", Location: "59°19′45″N 18°4′7″E", Lat: 59.329167, Lng: 18.068611},
+		{Name: "DMS ASCII", Location: `33°51'24.5"S 151°12'55.1"E`, Lat: -33.856806, Lng: 151.215306},
+		{Name: "DMS Unmarked", Location: "59 19 45 18 4 7", Lat: 59.329167, Lng: 18.068611},
+		{Name: "DDM", Location: "N59 19.75 E18 04.12", Lat: 59.329167, Lng: 18.068667},
+		{Name: "DDM Western", Location: "N51 30.455 W000 07.668", Lat: 51.507583, Lng: -0.1278},
+		{Name: "OS Grid Reference", Location: "TQ3015580412", Lat: 51.507658, Lng: -0.125970},
+		{Name: "Irish Grid Reference", Location: "J 33825 73948", Lat: 54.596048, Lng: -5.930215},
+		{Name: "UTM", Location: "30U 699375 5710164", Lat: 51.507381, Lng: -0.126954},
+		{Name: "UTM Southern", Location: "56H 334901 6252289", Lat: -33.8568, Lng: 151.2153},
+		{Name: "MGRS", Location: "33V XF 74031 80807", Lat: 59.330097, Lng: 18.059160},
+		{Name: "Maidenhead", Location: "IO91wm41", Lat: 51.50625, Lng: -0.129167},
+	}
+
+	for _, test := range testCases {
+		point, err := coordinates.Parse(test.Location)
+		if err != nil {
+			t.Fatalf("Location %s unexpectedly errored: %s", test.Location, err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > Threshold {
+			t.Fatalf("\n--- Incorrect Location ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	for _, location := range []string{
+		"", "hello", "91, 0", "59N 18", "59.5 30 18 4", "59 60 18 4", "59 18 4", "N59 N18", "-59N 18E", "61U 500000 5000000",
+		"N", "TQ", "TQ1", "TQ123", "TQ 301 8041",
+		"33V 500000 99999999", "33U 674031 6580807", "33W 674031 6580807", "33N 674031 6580807",
+	} {
+		if _, err := coordinates.Parse(location); err == nil {
+			t.Fatalf("\n--- Expected Error ---\nLOCATION: %s", location)
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	Threshold := 0.01 // metres
+
+	type TestCases struct {
+		Name     string
+		X        float64
+		Y        float64
+		From     int
+		To       int
+		Easting  float64
+		Northing float64
+	}
+	testCases := []TestCases{
+		{Name: "SWEREF 99 TM", X: 59.3293, Y: 18.0686, From: 4326, To: 3006, Easting: 674571.866, Northing: 6580743.008},
+		{Name: "ETRS89 UTM 33", X: 59.3293, Y: 18.0686, From: 4258, To: 25833, Easting: 674571.866, Northing: 6580743.008},
+		{Name: "SWEREF 99 TM to UTM 33", X: 674571.866, Y: 6580743.008, From: 3006, To: 32633, Easting: 674571.866, Northing: 6580743.008},
+	}
+
+	for _, test := range testCases {
+		easting, northing, err := coordinates.Transform(test.X, test.Y, test.From, test.To)
+		if err != nil {
+			t.Fatalf("Transform unexpectedly errored: %s", err.Error())
+		}
+		if math.Abs(easting-test.Easting) > Threshold || math.Abs(northing-test.Northing) > Threshold {
+			t.Fatalf("\n--- Incorrect Transform ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, easting, northing, test.Easting, test.Northing)
+		}
+	}
+
+	type GridCases struct {
+		Name   string
+		Lat    float64
+		Lng    float64
+		EPSG   int
+		System string
+	}
+	gridCases := []GridCases{
+		{Name: "National Grid", Lat: 51.4276, Lng: -0.1908, EPSG: 27700, System: "GB"},
+		{Name: "Irish Grid", Lat: 54.5973, Lng: -5.9301, EPSG: 29903, System: "IE"},
+		{Name: "ITM", Lat: 53.3498, Lng: -6.2603, EPSG: 2157, System: "ITM"},
+		{Name: "RD New", Lat: 52.3676, Lng: 4.9041, EPSG: 28992, System: "RD"},
+		{Name: "Belgian Lambert 72", Lat: 50.8503, Lng: 4.3517, EPSG: 31370, System: "BE72"},
+		{Name: "Belgian Lambert 2008", Lat: 50.8503, Lng: 4.3517, EPSG: 3812, System: "BE08"},
+		{Name: "UTM 30N", Lat: 51.4276, Lng: -0.1908, EPSG: 32630, System: "UTM30"},
+		{Name: "UTM 56S", Lat: -33.8568, Lng: 151.2153, EPSG: 32756, System: "UTM56"},
+	}
+
+	for _, test := range gridCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		gridReference, _ := lookup.GetGridReference(test.System)
+		easting, northing, err := lookup.GetEPSG(test.EPSG)
+		if err != nil {
+			t.Fatalf("GetEPSG unexpectedly errored: %s", err.Error())
+		}
+		if math.Abs(easting-gridReference.GetEasting()) > Threshold || math.Abs(northing-gridReference.GetNorthing()) > Threshold {
+			t.Fatalf("\n--- Incorrect EPSG ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, easting, northing, gridReference.GetEasting(), gridReference.GetNorthing())
+		}
+
+		point, err := coordinates.NewFromEPSG(test.EPSG, easting, northing)
+		if err != nil {
+			t.Fatalf("NewFromEPSG unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > 1 {
+			t.Fatalf("\n--- Incorrect Inverse ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	if _, _, err := coordinates.Transform(0, 0, 4326, 1); err == nil {
+		t.Fatalf("Expected unknown EPSG code to error")
+	}
+}
+
+func TestRegisterDatum(t *testing.T) {
+	Threshold := 0.01 // metres
+
+	datum, err := coordinates.RegisterDatum(3067, coordinates.DatumParameters{
+		Name:            "ETRS89 / TM35FIN",
+		Ellipsoid:       7019,
+		ScaleFactor:     0.9996,
+		OriginLongitude: 27,
+		FalseEasting:    500000,
+	})
+	if err != nil {
+		t.Fatalf("RegisterDatum unexpectedly errored: %s", err.Error())
+	}
+	if datum.GetName() != "ETRS89 / TM35FIN" {
+		t.Fatalf("\n--- Incorrect Name ---\n     GOT: %s\nEXPECTED: %s", datum.GetName(), "ETRS89 / TM35FIN")
+	}
+
+	easting, northing, err := coordinates.Transform(60.1699, 24.9384, 4326, 3067)
+	if err != nil {
+		t.Fatalf("Transform unexpectedly errored: %s", err.Error())
+	}
+	if math.Abs(easting-385611.317) > Threshold || math.Abs(northing-6672118.380) > Threshold {
+		t.Fatalf("\n--- Incorrect Transform ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", "Helsinki", easting, northing, 385611.317, 6672118.380)
+	}
+
+	if _, err := coordinates.RegisterDatum(27700, coordinates.DatumParameters{Ellipsoid: 7001}); err == nil {
+		t.Fatalf("Expected duplicate EPSG code to error")
+	}
+	if _, err := coordinates.RegisterDatum(99999, coordinates.DatumParameters{Ellipsoid: 1}); err == nil {
+		t.Fatalf("Expected unknown ellipsoid to error")
+	}
+
+	bessel, err := coordinates.RegisterEllipsoid(7004, "Bessel 1841", 6377397.155, 6356078.962818)
+	if err != nil {
+		t.Fatalf("RegisterEllipsoid unexpectedly errored: %s", err.Error())
+	}
+	ellipsoid, err := coordinates.GetEllipsoid(7004)
+	if err != nil || ellipsoid.GetName() != bessel.GetName() {
+		t.Fatalf("\n--- Incorrect Ellipsoid ---\n     GOT: %s\nEXPECTED: %s", ellipsoid.GetName(), bessel.GetName())
+	}
+	if _, err := coordinates.RegisterEllipsoid(7030, "WGS84", 6378137, 6356752.314245); err == nil {
+		t.Fatalf("Expected duplicate ellipsoid to error")
+	}
+
+	national, err := coordinates.GetDatum(27700)
+	if err != nil || national.GetName() != coordinates.NationalGrid.GetName() {
+		t.Fatalf("\n--- Incorrect Datum ---\n     GOT: %s\nEXPECTED: %s", national.GetName(), coordinates.NationalGrid.GetName())
+	}
+}
+
+func TestMaidenhead(t *testing.T) {
+	type TestCases struct {
+		Name    string
+		Lat     float64
+		Lng     float64
+		Locator string
+	}
+	testCases := []TestCases{
+		{Name: "London", Lat: 51.5074, Lng: -0.1278, Locator: "IO91wm41"},
+		{Name: "Newington", Lat: 41.714775, Lng: -72.727260, Locator: "FN31pr21"},
+		{Name: "Sydney", Lat: -33.8568, Lng: 151.2153, Locator: "QF56od54"},
+		{Name: "North East Corner", Lat: 90, Lng: 180, Locator: "RR99xx99"},
+		{Name: "South West Corner", Lat: -90, Lng: -180, Locator: "AA00aa00"},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		for characters := 2; characters <= 8; characters += 2 {
+			locator, err := lookup.GetMaidenhead(characters)
+			if err != nil {
+				t.Fatalf("Locator unexpectedly errored: %s", err.Error())
+			}
+			if locator != test.Locator[:characters] {
+				t.Fatalf("\n--- Incorrect Locator ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, locator, test.Locator[:characters])
+			}
+		}
+
+		// The centre of an 8 character square is within 0.5 km of any point
+		// in it.
+		point, err := coordinates.ParseMaidenhead(test.Locator)
+		if err != nil {
+			t.Fatalf("Locator unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > 500 {
+			t.Fatalf("\n--- Incorrect Point ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	point, _ := coordinates.ParseMaidenhead("IO91")
+	if point.GetLatitude() != 51.5 || point.GetLongitude() != -1 {
+		t.Fatalf("\n--- Incorrect Point ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", "IO91", point.GetLatitude(), point.GetLongitude(), 51.5, -1.0)
+	}
+
+	for _, locator := range []string{"", "I", "SA00", "IO9A", "IO91yy", "IO91wm4", "IO91wm45aa"} {
+		if _, err := coordinates.ParseMaidenhead(locator); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n LOCATOR: %s", locator)
+		}
+	}
+	lookup := coordinates.New(51.5074, -0.1278)
+	if _, err := lookup.GetMaidenhead(5); err == nil {
+		t.Fatalf("Expected odd locator length to error")
+	}
+}
+
+func TestGeohash(t *testing.T) {
+	type TestCases struct {
+		Name    string
+		Lat     float64
+		Lng     float64
+		Geohash string
+	}
+	testCases := []TestCases{
+		{Name: "Wikipedia Example", Lat: 57.64911, Lng: 10.40744, Geohash: "u4pruydqqvj"},
+		{Name: "London", Lat: 51.5074, Lng: -0.1278, Geohash: "gcpvj0duq53"},
+		{Name: "Sydney", Lat: -33.8568, Lng: 151.2153, Geohash: "r3gx2ux9ggh"},
+		{Name: "North East Corner", Lat: 90, Lng: 180, Geohash: "zzzzzzzzzzz"},
+		{Name: "South West Corner", Lat: -90, Lng: -180, Geohash: "00000000000"},
+	}
+
+	for _, test := range testCases {
+		lookup := coordinates.New(test.Lat, test.Lng)
+		for characters := 1; characters <= len(test.Geohash); characters++ {
+			geohash, err := lookup.GetGeohash(characters)
+			if err != nil {
+				t.Fatalf("Geohash unexpectedly errored: %s", err.Error())
+			}
+			if geohash != test.Geohash[:characters] {
+				t.Fatalf("\n--- Incorrect Geohash ---\n    NAME: %s\n     GOT: %s\nEXPECTED: %s", test.Name, geohash, test.Geohash[:characters])
+			}
+		}
+
+		point, err := coordinates.ParseGeohash(test.Geohash)
+		if err != nil {
+			t.Fatalf("Geohash unexpectedly errored: %s", err.Error())
+		}
+		if point.DistanceTo(test.Lat, test.Lng) > 1 {
+			t.Fatalf("\n--- Incorrect Point ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", test.Name, point.GetLatitude(), point.GetLongitude(), test.Lat, test.Lng)
+		}
+	}
+
+	point, _ := coordinates.ParseGeohash("EZS42")
+	if math.Abs(point.GetLatitude()-42.605) > 0.001 || math.Abs(point.GetLongitude()+5.603) > 0.001 {
+		t.Fatalf("\n--- Incorrect Point ---\n    NAME: %s\n     GOT: %f, %f\nEXPECTED: %f, %f", "ezs42", point.GetLatitude(), point.GetLongitude(), 42.605, -5.603)
+	}
+
+	for _, geohash := range []string{"", "u4pa", "u4pruydqqvjxx"} {
+		if _, err := coordinates.ParseGeohash(geohash); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n GEOHASH: %s", geohash)
+		}
+	}
+}
@@ -1,70 +1,251 @@
 package coordinates
 
-type datum struct {
+import (
+	"errors"
+	"fmt"
+)
+
+// Projections a Datum may use.
+const (
+	TransverseMercator = iota
+	LambertConformalConic
+)
+
+// Datum describes a grid system: its projection and origin, the ellipsoid
+// it is defined on, and the Helmert transformation from WGS84.
+type Datum struct {
 	name               string
+	projection         int
 	scaleFactor        float64
 	trueOriginPhi      float64
-	trueOriginLambda   int
-	trueOriginEasting  int
-	trueOriginNorthing int
+	trueOriginLambda   float64
+	trueOriginEasting  float64
+	trueOriginNorthing float64
+	standardParallels  [2]float64
 	helmertTransform   [3]float64
 	helmertScale       float64
 	helmertRotation    [3]float64
-	ellipsoid          ellipsoid
+	ellipsoid          Ellipsoid
+}
+
+// DatumParameters declares a grid system. Ellipsoid is the EPSG code of a
+// registered ellipsoid. Translation is in metres, Scale in parts per million
+// and Rotation in arc seconds, together the Helmert transformation from
+// WGS84 to the grid's own datum. StandardParallels are used by the Lambert
+// conformal conic projection only.
+type DatumParameters struct {
+	Name              string
+	Projection        int
+	Ellipsoid         int
+	ScaleFactor       float64
+	OriginLatitude    float64
+	OriginLongitude   float64
+	FalseEasting      float64
+	FalseNorthing     float64
+	StandardParallels [2]float64
+	Translation       [3]float64
+	Scale             float64
+	Rotation          [3]float64
 }
 
 var (
-	NationalGrid      datum
-	IrishNationalGrid datum
-	UtmNorth          datum
-	UtmSouth          datum
+	NationalGrid            Datum
+	IrishNationalGrid       Datum
+	IrishTransverseMercator Datum
+	UtmNorth                Datum
+	UtmSouth                Datum
+	Mga                     Datum
+	Etrs89Utm               Datum
+	Sweref99TM              Datum
+	BelgianLambert72        Datum
+	BelgianLambert08        Datum
+	Ed50Utm32               Datum
+
+	// NationalGridETRS89 is the National Grid projection applied directly to
+	// ETRS89 coordinates, the first step of the OSTN15 transformation.
+	NationalGridETRS89 Datum
 )
 
+// datumDefinitions are the national grids registered under their EPSG codes.
+var datumDefinitions = map[int]DatumParameters{
+	27700: {
+		Name:            "Ordnance Survey National Grid",
+		Ellipsoid:       7001,
+		ScaleFactor:     0.9996012717,
+		OriginLatitude:  49,
+		OriginLongitude: -2,
+		FalseEasting:    400000,
+		FalseNorthing:   -100000,
+		Translation:     [3]float64{-446.448, 125.157, -542.06},
+		Scale:           20.4894,
+		Rotation:        [3]float64{-0.1502, -0.247, -0.8421},
+	},
+	29903: {
+		Name:            "Irish National Grid",
+		Ellipsoid:       7002,
+		ScaleFactor:     1.000035,
+		OriginLatitude:  53.5,
+		OriginLongitude: -8,
+		FalseEasting:    200000,
+		FalseNorthing:   250000,
+		Translation:     [3]float64{-482.53, 130.596, -564.557},
+		Scale:           -8.15,
+		Rotation:        [3]float64{1.042, 0.214, 0.631},
+	},
+	2157: {
+		Name:            "Irish Transverse Mercator",
+		Ellipsoid:       7019,
+		ScaleFactor:     0.99982,
+		OriginLatitude:  53.5,
+		OriginLongitude: -8,
+		FalseEasting:    600000,
+		FalseNorthing:   750000,
+	},
+	3006: {
+		Name:            "SWEREF 99 TM",
+		Ellipsoid:       7019,
+		ScaleFactor:     0.9996,
+		OriginLongitude: 15,
+		FalseEasting:    500000,
+	},
+	31370: {
+		Name:              "Belgian Lambert 72",
+		Projection:        LambertConformalConic,
+		Ellipsoid:         7022,
+		StandardParallels: [2]float64{51.16666723333333, 49.8333339},
+		OriginLatitude:    90,
+		OriginLongitude:   4.367486666666667,
+		FalseEasting:      150000.013,
+		FalseNorthing:     5400088.438,
+		Translation:       [3]float64{106.8686, -52.2978, 103.7239},
+		Scale:             1.2747,
+		Rotation:          [3]float64{-0.3366, 0.457, -1.8422},
+	},
+	3812: {
+		Name:              "Belgian Lambert 2008",
+		Projection:        LambertConformalConic,
+		Ellipsoid:         7019,
+		StandardParallels: [2]float64{49.833333333333336, 51.166666666666664},
+		OriginLatitude:    50.797815,
+		OriginLongitude:   4.359215833333333,
+		FalseEasting:      649328,
+		FalseNorthing:     665262,
+	},
+}
+
+// zonedDatums are the families of UTM-style grids registered zone by zone,
+// from the EPSG code of the first zone.
+var zonedDatums = []struct {
+	code       int
+	firstZone  int
+	lastZone   int
+	name       string
+	parameters DatumParameters
+}{
+	{32601, 1, 60, "WGS 84 / UTM zone %dN", DatumParameters{Ellipsoid: 7030, ScaleFactor: 0.9996, FalseEasting: 500000}},
+	{32701, 1, 60, "WGS 84 / UTM zone %dS", DatumParameters{Ellipsoid: 7030, ScaleFactor: 0.9996, FalseEasting: 500000, FalseNorthing: 10000000}},
+	{25828, 28, 38, "ETRS89 / UTM zone %dN", DatumParameters{Ellipsoid: 7019, ScaleFactor: 0.9996, FalseEasting: 500000}},
+	{23028, 28, 38, "ED50 / UTM zone %dN", DatumParameters{Ellipsoid: 7022, ScaleFactor: 0.9996, FalseEasting: 500000, Translation: [3]float64{87, 98, 121}}},
+	{28348, 48, 58, "GDA94 / MGA zone %d", DatumParameters{Ellipsoid: 7019, ScaleFactor: 0.9996, FalseEasting: 500000, FalseNorthing: 10000000}},
+	{7846, 46, 59, "GDA2020 / MGA zone %d", DatumParameters{Ellipsoid: 7019, ScaleFactor: 0.9996, FalseEasting: 500000, FalseNorthing: 10000000}},
+}
+
 func init() {
-	NationalGrid = newDatum(
-		"Ordnance Survey National Grid", 0.9996012717,
-		49, -2, 400000, -100000,
-		-446.448, 125.157, -542.06, 20.4894,
-		-0.1502, -0.247, -0.8421, Airy1830)
-	IrishNationalGrid = newDatum(
-		"Irish National Grid", 1.000035,
-		53.5, -8, 200000, 250000,
-		-482.53, 130.596, -564.557, -8.15,
-		1.042, 0.214, 0.631, Airy1830Modified)
-	UtmNorth = newDatum(
-		"UTM Northern Hemisphere", 0.9996,
-		0, -3, 500000, 0, 0, 0, 0, 0, 0, 0, 0, WGS84)
-	UtmSouth = newDatum(
-		"UTM Southern Hemisphere", 0.9996,
-		0, -3, 500000, 10000000, 0, 0, 0, 0, 0, 0, 0, WGS84)
+	for code, parameters := range datumDefinitions {
+		if _, err := RegisterDatum(code, parameters); err != nil {
+			panic(err)
+		}
+	}
+	for _, family := range zonedDatums {
+		for zone := family.firstZone; zone <= family.lastZone; zone++ {
+			parameters := family.parameters
+			parameters.Name = fmt.Sprintf(family.name, zone)
+			parameters.OriginLongitude = float64(zone*6 - 183)
+			if _, err := RegisterDatum(family.code+zone-family.firstZone, parameters); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	NationalGrid = datums[27700]
+	IrishNationalGrid = datums[29903]
+	IrishTransverseMercator = datums[2157]
+	Sweref99TM = datums[3006]
+	BelgianLambert72 = datums[31370]
+	BelgianLambert08 = datums[3812]
+	Ed50Utm32 = datums[23032]
+
+	// The zoned grids are projected about -3°, the central meridian of zone
+	// 30, with the longitude shifted into that zone first.
+	UtmNorth = shiftedZoneDatum(32601, "UTM Northern Hemisphere")
+	UtmSouth = shiftedZoneDatum(32701, "UTM Southern Hemisphere")
+	Mga = shiftedZoneDatum(28348, "Map Grid of Australia")
+	Etrs89Utm = shiftedZoneDatum(25828, "ETRS89 UTM")
+
+	NationalGridETRS89 = mustDatum(DatumParameters{
+		Name: "Ordnance Survey National Grid (ETRS89)", Ellipsoid: 7019, ScaleFactor: 0.9996012717,
+		OriginLatitude: 49, OriginLongitude: -2, FalseEasting: 400000, FalseNorthing: -100000,
+	})
 }
 
-func newDatum(
-	name string,
-	scaleFactor float64,
-	trueOriginPhi float64,
-	trueOriginLambda int,
-	trueOriginEasting int,
-	trueOriginNorthing int,
-	helmertTransformX float64,
-	helmertTransformY float64,
-	helmertTransformZ float64,
-	helmertScale float64,
-	helmertRotationX float64,
-	helmertRotationY float64,
-	helmertRotationZ float64,
-	ellipsoid ellipsoid,
-) datum {
-	return datum{
-		name:               name,
+// NewDatum returns the grid system declared by the parameters.
+func NewDatum(parameters DatumParameters) (Datum, error) {
+	ellipsoid, err := GetEllipsoid(parameters.Ellipsoid)
+	if err != nil {
+		return Datum{}, err
+	}
+	if parameters.Projection != TransverseMercator && parameters.Projection != LambertConformalConic {
+		return Datum{}, errors.New("invalid projection")
+	}
+	scaleFactor := parameters.ScaleFactor
+	if parameters.Projection == LambertConformalConic {
+		scaleFactor = 1
+	}
+
+	return Datum{
+		name:               parameters.Name,
+		projection:         parameters.Projection,
 		scaleFactor:        scaleFactor,
-		trueOriginPhi:      trueOriginPhi,
-		trueOriginLambda:   trueOriginLambda,
-		trueOriginEasting:  trueOriginEasting,
-		trueOriginNorthing: trueOriginNorthing,
-		helmertTransform:   [3]float64{helmertTransformX, helmertTransformY, helmertTransformZ},
-		helmertScale:       helmertScale,
-		helmertRotation:    [3]float64{helmertRotationX, helmertRotationY, helmertRotationZ},
+		trueOriginPhi:      parameters.OriginLatitude,
+		trueOriginLambda:   parameters.OriginLongitude,
+		trueOriginEasting:  parameters.FalseEasting,
+		trueOriginNorthing: parameters.FalseNorthing,
+		standardParallels:  parameters.StandardParallels,
+		helmertTransform:   parameters.Translation,
+		helmertScale:       parameters.Scale,
+		helmertRotation:    parameters.Rotation,
 		ellipsoid:          ellipsoid,
+	}, nil
+}
+
+// shiftedZoneDatum returns the zonedDatums family registered from code with
+// its origin on the central meridian of zone 30.
+func shiftedZoneDatum(code int, name string) Datum {
+	for _, family := range zonedDatums {
+		if family.code == code {
+			parameters := family.parameters
+			parameters.Name = name
+			parameters.OriginLongitude = -3
+			return mustDatum(parameters)
+		}
 	}
+	panic(fmt.Sprintf("no zoned datum family %d", code))
+}
+
+func mustDatum(parameters DatumParameters) Datum {
+	datum, err := NewDatum(parameters)
+	if err != nil {
+		panic(err)
+	}
+	return datum
+}
+
+// GetName returns the name of the grid system.
+func (s *Datum) GetName() string {
+	return s.name
+}
+
+// GetEllipsoid returns the ellipsoid the grid system is defined on.
+func (s *Datum) GetEllipsoid() Ellipsoid {
+	return s.ellipsoid
 }
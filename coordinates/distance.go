@@ -0,0 +1,42 @@
+package coordinates
+
+import "math"
+
+const meanEarthRadius = 6371008.8
+
+// DistanceTo returns the great-circle distance in metres between the
+// point and the given WGS84 latitude and longitude.
+func (s *Point) DistanceTo(latitude float64, longitude float64) float64 {
+	phi1 := degreesToRadians(s.latitude)
+	phi2 := degreesToRadians(latitude)
+	deltaPhi := phi2 - phi1
+	deltaLambda := degreesToRadians(longitude - s.longitude)
+
+	a := math.Pow(math.Sin(deltaPhi/2), 2.0) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(deltaLambda/2), 2.0)
+	return 2 * meanEarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// BearingTo returns the initial great-circle bearing in degrees from north
+// from the point to the given WGS84 latitude and longitude.
+func (s *Point) BearingTo(latitude float64, longitude float64) float64 {
+	phi1 := degreesToRadians(s.latitude)
+	phi2 := degreesToRadians(latitude)
+	deltaLambda := degreesToRadians(longitude - s.longitude)
+
+	y := math.Sin(deltaLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(deltaLambda)
+	return normaliseBearing(radiansToDegrees(math.Atan2(y, x)))
+}
+
+// Destination returns the point reached by travelling a distance in metres
+// along a great circle from the point at a bearing in degrees.
+func (s *Point) Destination(bearing float64, distance float64) Point {
+	phi1 := degreesToRadians(s.latitude)
+	lambda1 := degreesToRadians(s.longitude)
+	theta := degreesToRadians(bearing)
+	delta := distance / meanEarthRadius
+
+	phi2 := math.Asin(math.Sin(phi1)*math.Cos(delta) + math.Cos(phi1)*math.Sin(delta)*math.Cos(theta))
+	lambda2 := lambda1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(phi1), math.Cos(delta)-math.Sin(phi1)*math.Sin(phi2))
+	return New(radiansToDegrees(phi2), normaliseLongitude(radiansToDegrees(lambda2)))
+}
@@ -0,0 +1,36 @@
+// Package coordinates converts WGS84 latitude and longitude into the
+// national grids used by spectrum regulators.
+//
+// A Point is created from decimal degrees with New, from degrees, minutes
+// and seconds with NewFromDegrees, or from text in any of the common formats
+// with Parse. GetGridReference then projects it onto a named grid system,
+// returning a GridReference holding the easting, northing and, where the
+// grid has one, the lettered grid square code:
+//
+//	point := coordinates.New(51.4276, -0.1908)
+//	gridReference, err := point.GetGridReference("GB")
+//	// gridReference.GetCode() == "TQ2587671396"
+//
+// Each grid is described by a Datum, which couples the projection
+// parameters with an Ellipsoid and the Helmert transformation from WGS84.
+// Ellipsoids and datums are registered by EPSG code, and Transform converts
+// between any two registered systems:
+//
+//	easting, northing, err := coordinates.Transform(51.4276, -0.1908, 4326, 27700)
+//
+// Further national grids are declared as DatumParameters and added with
+// RegisterDatum.
+//
+// The OSTN15 grid system replaces the Helmert transformation for the
+// National Grid with the Ordnance Survey grid shift, accurate to about 0.1 m.
+// Its data file is not distributed with the package: embed it by building
+// with -tags ostn15, name it in the OSTN15_DATA environment variable, or load
+// it with LoadOSTN15. Without it, OSTN15 returns ErrOSTN15Unavailable rather
+// than quietly using the Helmert transformation of the GB grid system, which
+// OS puts at about 5 m.
+//
+// DistanceTo, BearingTo and Destination work on a sphere and are the fast
+// path for rough ranges. GeodesicTo and GeodesicDestination, and the Inverse
+// and Direct methods of an Ellipsoid, solve the geodesic on the ellipsoid to
+// under a millimetre with Vincenty's formulae.
+package coordinates
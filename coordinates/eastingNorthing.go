@@ -2,16 +2,19 @@ package coordinates
 
 import "math"
 
-type eastingNorthing struct {
+// EastingNorthing is a position on a projected grid, in metres.
+type EastingNorthing struct {
 	easting  float64
 	northing float64
 }
 
+// NewEastingsNorthings projects a latitude and longitude, already expressed
+// on the datum's ellipsoid, onto its Transverse Mercator grid.
 func NewEastingsNorthings(
 	latitude float64,
 	longitude float64,
-	datum datum,
-) eastingNorthing {
+	datum Datum,
+) EastingNorthing {
 	phi := degreesToRadians(latitude)
 	lambda := degreesToRadians(longitude)
 
@@ -20,9 +23,9 @@ func NewEastingsNorthings(
 	b := ellipsoid.polarRadius
 	eSq := ellipsoid.eccentricitySquared
 	phi0 := degreesToRadians(datum.trueOriginPhi)
-	lambda0 := degreesToRadians(float64(datum.trueOriginLambda))
-	N0 := float64(datum.trueOriginNorthing)
-	E0 := float64(datum.trueOriginEasting)
+	lambda0 := degreesToRadians(datum.trueOriginLambda)
+	N0 := datum.trueOriginNorthing
+	E0 := datum.trueOriginEasting
 	f0 := datum.scaleFactor
 
 	n := (a - b) / (a + b)
@@ -30,11 +33,7 @@ func NewEastingsNorthings(
 	p := (a * f0) * (1.0 - eSq) * math.Pow(1.0-(eSq*math.Pow(math.Sin(phi), 2.0)), -1.5)
 	nSq := (v / p) - 1.0
 
-	M := (1 + n + (5 / 4.0 * math.Pow(n, 2.0)) + (5 / 4.0 * math.Pow(n, 3.0))) * (phi - phi0)
-	M = M - ((3*n + 3*math.Pow(n, 2.0) + 21/8.0*math.Pow(n, 3.0)) * math.Sin(phi-phi0) * math.Cos(phi+phi0))
-	M = M + (((15 / 8.0 * math.Pow(n, 2.0)) + (15 / 8.0 * math.Pow(n, 3.0))) * math.Sin(2*(phi-phi0)) * math.Cos(2*(phi+phi0)))
-	M = M - (35 / 24.0 * math.Pow(n, 3.0) * math.Sin(3*(phi-phi0)) * math.Cos(3*(phi+phi0)))
-	M = b * f0 * M
+	M := meridionalArc(phi, phi0, n, b*f0)
 	I := M + N0
 	II := v / 2.0 * math.Sin(phi) * math.Cos(phi)
 	III := v / 24.0 * math.Sin(phi) * math.Pow(math.Cos(phi), 3.0) * (5.0 - math.Pow(math.Tan(phi), 2.0) + 9.0*nSq)
@@ -46,8 +45,157 @@ func NewEastingsNorthings(
 	VI := v / 120.0 * math.Pow(math.Cos(phi), 5.0) * (5.0 - 18.0*math.Pow(math.Tan(phi), 2.0) + math.Pow(math.Tan(phi), 4.0) + 14.0*nSq - 58.0*(math.Pow(math.Tan(phi), 2.0)*nSq))
 	easting := E0 + IV*(lambda-lambda0) + V*math.Pow(lambda-lambda0, 3.0) + VI*math.Pow(lambda-lambda0, 5.0)
 
-	return eastingNorthing{
+	return EastingNorthing{
 		easting:  easting,
 		northing: northing,
 	}
 }
+
+// meridionalArc returns the developed meridional arc from phi0 to phi, where
+// bf0 is the polar radius multiplied by the central meridian scale factor.
+func meridionalArc(phi float64, phi0 float64, n float64, bf0 float64) float64 {
+	M := (1 + n + (5 / 4.0 * math.Pow(n, 2.0)) + (5 / 4.0 * math.Pow(n, 3.0))) * (phi - phi0)
+	M = M - ((3*n + 3*math.Pow(n, 2.0) + 21/8.0*math.Pow(n, 3.0)) * math.Sin(phi-phi0) * math.Cos(phi+phi0))
+	M = M + (((15 / 8.0 * math.Pow(n, 2.0)) + (15 / 8.0 * math.Pow(n, 3.0))) * math.Sin(2*(phi-phi0)) * math.Cos(2*(phi+phi0)))
+	M = M - (35 / 24.0 * math.Pow(n, 3.0) * math.Sin(3*(phi-phi0)) * math.Cos(3*(phi+phi0)))
+	return bf0 * M
+}
+
+// toLatitudeLongitude is the inverse of NewEastingsNorthings, returning the
+// latitude and longitude on the datum's ellipsoid.
+func (s *EastingNorthing) toLatitudeLongitude(datum Datum) (float64, float64) {
+	ellipsoid := datum.ellipsoid
+	a := ellipsoid.equatorialRadius
+	b := ellipsoid.polarRadius
+	eSq := ellipsoid.eccentricitySquared
+	phi0 := degreesToRadians(datum.trueOriginPhi)
+	lambda0 := degreesToRadians(datum.trueOriginLambda)
+	N0 := datum.trueOriginNorthing
+	E0 := datum.trueOriginEasting
+	f0 := datum.scaleFactor
+
+	n := (a - b) / (a + b)
+
+	phi := (s.northing-N0)/(a*f0) + phi0
+	M := meridionalArc(phi, phi0, n, b*f0)
+	for math.Abs(s.northing-N0-M) >= 0.00001 {
+		phi = (s.northing-N0-M)/(a*f0) + phi
+		M = meridionalArc(phi, phi0, n, b*f0)
+	}
+
+	v := (a * f0) * math.Pow(1.0-(eSq*math.Pow(math.Sin(phi), 2)), -0.5)
+	p := (a * f0) * (1.0 - eSq) * math.Pow(1.0-(eSq*math.Pow(math.Sin(phi), 2.0)), -1.5)
+	nSq := (v / p) - 1.0
+
+	tan := math.Tan(phi)
+	sec := 1 / math.Cos(phi)
+	VII := tan / (2 * p * v)
+	VIII := tan / (24 * p * math.Pow(v, 3.0)) * (5 + 3*math.Pow(tan, 2.0) + nSq - 9*math.Pow(tan, 2.0)*nSq)
+	IX := tan / (720 * p * math.Pow(v, 5.0)) * (61 + 90*math.Pow(tan, 2.0) + 45*math.Pow(tan, 4.0))
+	X := sec / v
+	XI := sec / (6 * math.Pow(v, 3.0)) * (v/p + 2*math.Pow(tan, 2.0))
+	XII := sec / (120 * math.Pow(v, 5.0)) * (5 + 28*math.Pow(tan, 2.0) + 24*math.Pow(tan, 4.0))
+	XIIA := sec / (5040 * math.Pow(v, 7.0)) * (61 + 662*math.Pow(tan, 2.0) + 1320*math.Pow(tan, 4.0) + 720*math.Pow(tan, 6.0))
+
+	dE := s.easting - E0
+	phi = phi - VII*math.Pow(dE, 2.0) + VIII*math.Pow(dE, 4.0) - IX*math.Pow(dE, 6.0)
+	lambda := lambda0 + X*dE - XI*math.Pow(dE, 3.0) + XII*math.Pow(dE, 5.0) - XIIA*math.Pow(dE, 7.0)
+
+	return radiansToDegrees(phi), radiansToDegrees(lambda)
+}
+
+func newLambertEastingsNorthings(
+	latitude float64,
+	longitude float64,
+	datum Datum,
+) EastingNorthing {
+	phi := degreesToRadians(latitude)
+	lambda := degreesToRadians(longitude)
+
+	ellipsoid := datum.ellipsoid
+	a := ellipsoid.equatorialRadius
+	e := math.Sqrt(ellipsoid.eccentricitySquared)
+	phi0 := degreesToRadians(datum.trueOriginPhi)
+	lambda0 := degreesToRadians(datum.trueOriginLambda)
+	phi1 := degreesToRadians(datum.standardParallels[0])
+	phi2 := degreesToRadians(datum.standardParallels[1])
+	N0 := datum.trueOriginNorthing
+	E0 := datum.trueOriginEasting
+
+	m1 := lambertM(phi1, e)
+	m2 := lambertM(phi2, e)
+	t1 := lambertT(phi1, e)
+	t2 := lambertT(phi2, e)
+
+	n := (math.Log(m1) - math.Log(m2)) / (math.Log(t1) - math.Log(t2))
+	F := m1 / (n * math.Pow(t1, n))
+	r := a * F * math.Pow(lambertT(phi, e), n)
+	r0 := a * F * math.Pow(lambertT(phi0, e), n)
+	theta := n * (lambda - lambda0)
+
+	return EastingNorthing{
+		easting:  E0 + r*math.Sin(theta),
+		northing: N0 + r0 - r*math.Cos(theta),
+	}
+}
+
+func lambertM(phi float64, e float64) float64 {
+	return math.Cos(phi) / math.Sqrt(1-math.Pow(e*math.Sin(phi), 2.0))
+}
+
+func lambertT(phi float64, e float64) float64 {
+	if phi >= math.Pi/2 {
+		return 0
+	}
+	return math.Tan(math.Pi/4-phi/2) / math.Pow((1-e*math.Sin(phi))/(1+e*math.Sin(phi)), e/2)
+}
+
+// GetEasting returns the easting in metres.
+func (s *EastingNorthing) GetEasting() float64 {
+	return s.easting
+}
+
+// GetNorthing returns the northing in metres.
+func (s *EastingNorthing) GetNorthing() float64 {
+	return s.northing
+}
+
+// lambertToLatitudeLongitude is the inverse of newLambertEastingsNorthings,
+// returning the latitude and longitude on the datum's ellipsoid.
+func (s *EastingNorthing) lambertToLatitudeLongitude(datum Datum) (float64, float64) {
+	ellipsoid := datum.ellipsoid
+	a := ellipsoid.equatorialRadius
+	e := math.Sqrt(ellipsoid.eccentricitySquared)
+	phi0 := degreesToRadians(datum.trueOriginPhi)
+	lambda0 := degreesToRadians(datum.trueOriginLambda)
+	phi1 := degreesToRadians(datum.standardParallels[0])
+	phi2 := degreesToRadians(datum.standardParallels[1])
+	N0 := datum.trueOriginNorthing
+	E0 := datum.trueOriginEasting
+
+	m1 := lambertM(phi1, e)
+	m2 := lambertM(phi2, e)
+	t1 := lambertT(phi1, e)
+	t2 := lambertT(phi2, e)
+
+	n := (math.Log(m1) - math.Log(m2)) / (math.Log(t1) - math.Log(t2))
+	F := m1 / (n * math.Pow(t1, n))
+	r0 := a * F * math.Pow(lambertT(phi0, e), n)
+
+	dE := s.easting - E0
+	dN := r0 - (s.northing - N0)
+	r := math.Copysign(math.Hypot(dE, dN), n)
+	t := math.Pow(r/(a*F), 1/n)
+	theta := math.Atan2(dE, dN)
+	if n < 0 {
+		theta = math.Atan2(-dE, -dN)
+	}
+
+	phi := math.Pi/2 - 2*math.Atan(t)
+	for i := 0; i < 10; i++ {
+		phi = math.Pi/2 - 2*math.Atan(t*math.Pow((1-e*math.Sin(phi))/(1+e*math.Sin(phi)), e/2))
+	}
+	lambda := theta/n + lambda0
+
+	return radiansToDegrees(phi), radiansToDegrees(lambda)
+}
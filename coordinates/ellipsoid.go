@@ -2,7 +2,9 @@ package coordinates
 
 import "math"
 
-type ellipsoid struct {
+// Ellipsoid is a reference ellipsoid given by its equatorial and polar radii
+// in metres.
+type Ellipsoid struct {
 	name                string
 	equatorialRadius    float64
 	polarRadius         float64
@@ -10,15 +12,25 @@ type ellipsoid struct {
 	eccentricitySquared float64
 }
 
+// ellipsoids are the reference ellipsoids keyed by EPSG code.
+var ellipsoids = map[int]Ellipsoid{
+	7030: newEllipsoid("WGS84", 6378137, 6356752.314245),
+	7019: newEllipsoid("GRS80", 6378137, 6356752.3141),
+	7001: newEllipsoid("Airy 1830", 6377563.396, 6356256.909),
+	7002: newEllipsoid("Airy 1830 Modified", 6377340.189, 6356034.447),
+	7022: newEllipsoid("International 1924", 6378388, 6356911.946128),
+}
+
 var (
-	WGS84            ellipsoid
-	GRS80            ellipsoid
-	Airy1830         ellipsoid
-	Airy1830Modified ellipsoid
+	WGS84             = ellipsoids[7030]
+	GRS80             = ellipsoids[7019]
+	Airy1830          = ellipsoids[7001]
+	Airy1830Modified  = ellipsoids[7002]
+	International1924 = ellipsoids[7022]
 )
 
-func newEllipsoid(name string, equatorialRadius float64, polarRadius float64) ellipsoid {
-	return ellipsoid{
+func newEllipsoid(name string, equatorialRadius float64, polarRadius float64) Ellipsoid {
+	return Ellipsoid{
 		name:                name,
 		equatorialRadius:    equatorialRadius,
 		polarRadius:         polarRadius,
@@ -26,3 +38,28 @@ func newEllipsoid(name string, equatorialRadius float64, polarRadius float64) el
 		eccentricitySquared: (math.Pow(equatorialRadius, 2.0) - math.Pow(polarRadius, 2.0)) / math.Pow(equatorialRadius, 2.0),
 	}
 }
+
+// GetName returns the name of the ellipsoid.
+func (s *Ellipsoid) GetName() string {
+	return s.name
+}
+
+// GetEquatorialRadius returns the semi-major axis in metres.
+func (s *Ellipsoid) GetEquatorialRadius() float64 {
+	return s.equatorialRadius
+}
+
+// GetPolarRadius returns the semi-minor axis in metres.
+func (s *Ellipsoid) GetPolarRadius() float64 {
+	return s.polarRadius
+}
+
+// GetFlattening returns the flattening of the ellipsoid.
+func (s *Ellipsoid) GetFlattening() float64 {
+	return s.flattening
+}
+
+// GetEccentricitySquared returns the square of the first eccentricity.
+func (s *Ellipsoid) GetEccentricitySquared() float64 {
+	return s.eccentricitySquared
+}
@@ -0,0 +1,28 @@
+package coordinates_test
+
+import (
+	"fmt"
+
+	"github.com/stebunting/rfxp-backend/coordinates"
+)
+
+func ExamplePoint_GetGridReference() {
+	point := coordinates.New(51.4276, -0.1908)
+	gridReference, err := point.GetGridReference("GB")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(gridReference.GetCode())
+	// Output: TQ2587671396
+}
+
+func ExampleTransform() {
+	easting, northing, err := coordinates.Transform(51.4276, -0.1908, 4326, 27700)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.0f %.0f\n", easting, northing)
+	// Output: 525876 171396
+}
@@ -0,0 +1,141 @@
+package coordinates
+
+import (
+	"errors"
+	"math"
+)
+
+// Geodesics on the ellipsoid are solved with Vincenty's formulae, which are
+// accurate to well under a millimetre but fail to converge for nearly
+// antipodal points. The great-circle methods in distance.go are the fast
+// path where an error of up to 0.5% is acceptable.
+const (
+	vincentyTolerance  = 1e-12
+	vincentyIterations = 200
+)
+
+// Inverse returns the geodesic distance in metres between two latitudes and
+// longitudes on the ellipsoid, with the initial and final bearings in
+// degrees from north. It returns an error for nearly antipodal points.
+func (s *Ellipsoid) Inverse(latitude1 float64, longitude1 float64, latitude2 float64, longitude2 float64) (float64, float64, float64, error) {
+	a := s.equatorialRadius
+	b := s.polarRadius
+	f := s.flattening
+
+	L := degreesToRadians(normaliseLongitude(longitude2 - longitude1))
+	U1 := math.Atan((1 - f) * math.Tan(degreesToRadians(latitude1)))
+	U2 := math.Atan((1 - f) * math.Tan(degreesToRadians(latitude2)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinLambda, cosLambda, sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+	converged := false
+	for i := 0; i < vincentyIterations; i++ {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0, 0, 0, nil
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-previous) < vincentyTolerance {
+			converged = true
+			break
+		}
+	}
+	if !converged || math.Abs(lambda) > math.Pi {
+		return 0, 0, 0, errors.New("geodesic failed to converge")
+	}
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	distance := b * A * (sigma - deltaSigma)
+
+	initialBearing := math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+	finalBearing := math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda)
+	return distance, normaliseBearing(radiansToDegrees(initialBearing)), normaliseBearing(radiansToDegrees(finalBearing)), nil
+}
+
+// Direct returns the latitude and longitude reached by travelling a distance
+// in metres along the geodesic from a latitude and longitude at an initial
+// bearing in degrees, with the final bearing at that point.
+func (s *Ellipsoid) Direct(latitude float64, longitude float64, bearing float64, distance float64) (float64, float64, float64) {
+	a := s.equatorialRadius
+	b := s.polarRadius
+	f := s.flattening
+
+	sinAlpha1, cosAlpha1 := math.Sincos(degreesToRadians(bearing))
+	tanU1 := (1 - f) * math.Tan(degreesToRadians(latitude))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	sigma1 := math.Atan2(tanU1, cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSqAlpha := 1 - sinAlpha*sinAlpha
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+
+	sigma := distance / (b * A)
+	var sinSigma, cosSigma, cos2SigmaM float64
+	for i := 0; i < vincentyIterations; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+			B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		previous := sigma
+		sigma = distance/(b*A) + deltaSigma
+		if math.Abs(sigma-previous) < vincentyTolerance {
+			break
+		}
+	}
+	sinSigma, cosSigma = math.Sincos(sigma)
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	phi2 := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Hypot(sinAlpha, x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+	L := lambda - (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+	finalBearing := math.Atan2(sinAlpha, -x)
+
+	return radiansToDegrees(phi2), normaliseLongitude(longitude + radiansToDegrees(L)), normaliseBearing(radiansToDegrees(finalBearing))
+}
+
+// GeodesicTo returns the WGS84 geodesic distance in metres from the point to
+// the given latitude and longitude, with the initial bearing in degrees.
+func (s *Point) GeodesicTo(latitude float64, longitude float64) (float64, float64, error) {
+	distance, bearing, _, err := WGS84.Inverse(s.latitude, s.longitude, latitude, longitude)
+	return distance, bearing, err
+}
+
+// GeodesicDestination returns the point reached by travelling a distance in
+// metres along the WGS84 geodesic from the point at a bearing in degrees.
+func (s *Point) GeodesicDestination(bearing float64, distance float64) Point {
+	latitude, longitude, _ := WGS84.Direct(s.latitude, s.longitude, bearing, distance)
+	return New(latitude, longitude)
+}
+
+func normaliseBearing(bearing float64) float64 {
+	bearing = math.Mod(bearing, 360)
+	if bearing < 0 {
+		bearing += 360
+	}
+	return bearing
+}
+
+func normaliseLongitude(longitude float64) float64 {
+	return math.Mod(math.Mod(longitude+180, 360)+360, 360) - 180
+}
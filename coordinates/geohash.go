@@ -0,0 +1,79 @@
+package coordinates
+
+import (
+	"errors"
+	"strings"
+)
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// GetGeohash returns the geohash of the point to between 1 and 12
+// characters. Nine characters resolve to about 5 metres.
+func (s *Point) GetGeohash(characters int) (string, error) {
+	if characters < 1 || characters > 12 {
+		return "", errors.New("geohash must have between 1 and 12 characters")
+	}
+
+	latitude := [2]float64{-90, 90}
+	longitude := [2]float64{-180, 180}
+	hash := make([]byte, 0, characters)
+	even := true
+	index := 0
+	for bit := 0; len(hash) < characters; bit++ {
+		// Bits alternate between longitude and latitude, starting with
+		// longitude, each halving the interval the point lies in.
+		interval, value := &latitude, s.latitude
+		if even {
+			interval, value = &longitude, s.longitude
+		}
+		middle := (interval[0] + interval[1]) / 2
+		index <<= 1
+		if value >= middle {
+			index |= 1
+			interval[0] = middle
+		} else {
+			interval[1] = middle
+		}
+		even = !even
+
+		if bit%5 == 4 {
+			hash = append(hash, geohashAlphabet[index])
+			index = 0
+		}
+	}
+	return string(hash), nil
+}
+
+// ParseGeohash returns the point at the centre of the cell described by a
+// geohash.
+func ParseGeohash(hash string) (Point, error) {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	if len(hash) < 1 || len(hash) > 12 {
+		return Point{}, errors.New("geohash must have between 1 and 12 characters")
+	}
+
+	latitude := [2]float64{-90, 90}
+	longitude := [2]float64{-180, 180}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		index := strings.IndexByte(geohashAlphabet, hash[i])
+		if index < 0 {
+			return Point{}, errors.New("invalid geohash")
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			interval := &latitude
+			if even {
+				interval = &longitude
+			}
+			middle := (interval[0] + interval[1]) / 2
+			if index&mask != 0 {
+				interval[0] = middle
+			} else {
+				interval[1] = middle
+			}
+			even = !even
+		}
+	}
+
+	return New((latitude[0]+latitude[1])/2, (longitude[0]+longitude[1])/2), nil
+}
@@ -1,8 +1,28 @@
 package coordinates
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
 
-type gridReference struct {
+// gridLetters are the letters used to name grid squares, omitting I.
+const gridLetters = "ABCDEFGHJKLMNOPQRSTUVWXYZ"
+
+// channelIslandSquares names the 100 km squares of UTM zone 30U around the
+// Channel Islands by their easting and northing index: CJ covers Jersey,
+// Guernsey, Herm and Sark, and CA covers Alderney, Burhou and the Casquets.
+var channelIslandSquares = map[[2]int]string{
+	{5, 54}: "CJ",
+	{5, 55}: "CA",
+}
+
+// GridReference is a point projected onto a grid system. The code and short
+// code are only set for grids with lettered squares: GB, OSTN15, IE and the
+// Channel Islands part of UTM zone 30.
+type GridReference struct {
 	latitude           float64
 	longitude          float64
 	easting            float64
@@ -14,16 +34,18 @@ type gridReference struct {
 	shortCode          string
 }
 
+// NewGridReference returns the grid reference of the point at the given
+// easting and northing on a grid system.
 func NewGridReference(
-	coordinates *coordinates,
-	eastingNorthing eastingNorthing,
+	point *Point,
+	eastingNorthing EastingNorthing,
 	gridSystem string,
 	zone int,
 	northernHemisphere bool,
-) gridReference {
-	g := gridReference{
-		latitude:           coordinates.latitude,
-		longitude:          coordinates.longitude,
+) GridReference {
+	g := GridReference{
+		latitude:           point.latitude,
+		longitude:          point.longitude,
 		easting:            eastingNorthing.easting,
 		northing:           eastingNorthing.northing,
 		zone:               zone,
@@ -36,18 +58,125 @@ func NewGridReference(
 	return g
 }
 
-func (s *gridReference) setGridReference() {
+// ParseGridReference parses a lettered grid reference on the GB, IE or
+// Channel Islands grid, e.g. "TQ 30155 80412" or "J 33825 73948". Spaces
+// are ignored and the digits may be given to any even length up to 12;
+// shorter references resolve to the south west corner of the square they
+// describe.
+func ParseGridReference(code string) (GridReference, error) {
+	code = strings.ToUpper(strings.Join(strings.Fields(code), ""))
+
+	i := strings.IndexFunc(code, func(r rune) bool { return r >= '0' && r <= '9' })
+	if i < 0 {
+		i = len(code)
+	}
+	letters, digits := code[:i], code[i:]
+	if len(digits)%2 != 0 || len(digits) > 12 {
+		return GridReference{}, errors.New("invalid grid reference digits")
+	}
+
+	g, ok := findGridSquare(letters)
+	if !ok {
+		return GridReference{}, errors.New("invalid grid square")
+	}
+
+	precision := len(digits) / 2
+	if precision > 0 {
+		easting, err := strconv.Atoi(digits[:precision])
+		if err != nil {
+			return GridReference{}, errors.New("invalid grid reference digits")
+		}
+		northing, err := strconv.Atoi(digits[precision:])
+		if err != nil {
+			return GridReference{}, errors.New("invalid grid reference digits")
+		}
+		scale := math.Pow(10, float64(5-precision))
+		g.easting += float64(easting) * scale
+		g.northing += float64(northing) * scale
+	}
+
+	var point Point
+	switch g.gridSystem {
+	case "GB":
+		point = inverseTransform(EastingNorthing{easting: g.easting, northing: g.northing}, NationalGrid)
+	case "IE":
+		point = inverseTransform(EastingNorthing{easting: g.easting, northing: g.northing}, IrishNationalGrid)
+	default:
+		point = NewFromUTM(g.zone, true, g.easting, g.northing)
+	}
+	g.latitude = point.latitude
+	g.longitude = point.longitude
+	g.setGridReference()
+
+	return g, nil
+}
+
+// findGridSquare returns a GridReference at the south west corner of the
+// lettered square. Irish squares may be written without the leading I.
+func findGridSquare(letters string) (GridReference, bool) {
+	if len(letters) == 1 {
+		letters = "I" + letters
+	}
+	if len(letters) != 2 {
+		return GridReference{}, false
+	}
+
+	for square, code := range channelIslandSquares {
+		if code == letters {
+			return GridReference{
+				easting:            float64(square[0] * 100000),
+				northing:           float64(square[1] * 100000),
+				gridSystem:         "UTM",
+				zone:               30,
+				northernHemisphere: true,
+			}, true
+		}
+	}
+
+	second := strings.IndexByte(gridLetters, letters[1])
+	if second < 0 {
+		return GridReference{}, false
+	}
+
+	g := GridReference{zone: 1, northernHemisphere: true}
+	var e, n int
+	if letters[0] == 'I' {
+		g.gridSystem = "IE"
+		e = second % 5
+		n = 4 - second/5
+	} else {
+		first := strings.IndexByte(gridLetters, letters[0])
+		if first < 0 {
+			return GridReference{}, false
+		}
+		g.gridSystem = "GB"
+		e = (first-2)%5*5 + second%5
+		n = 19 - first/5*5 - second/5
+		if getGbCode(e, n) != letters {
+			return GridReference{}, false
+		}
+	}
+	g.easting = float64(e * 100000)
+	g.northing = float64(n * 100000)
+	return g, true
+}
+
+func (s *GridReference) setGridReference() {
+	if s.easting < 0 || s.northing < 0 {
+		return
+	}
 	eastingStr := fmt.Sprintf("%08d", int(s.easting))
 	northingStr := fmt.Sprintf("%08d", int(s.northing))
-	code := fmt.Sprintf("%s%s", eastingStr[0:3], northingStr[0:3])
+	e := int(s.easting) / 100000
+	n := int(s.northing) / 100000
 
 	var gridSquare string
-	if s.gridSystem == "GB" {
-		gridSquare = s.getGbCode(code)
+	if s.gridSystem == "GB" || s.gridSystem == "OSTN15" {
+		gridSquare = getGbCode(e, n)
 	} else if s.gridSystem == "IE" {
-		gridSquare = s.getIeCode(code)
+		gridSquare = getIeCode(e, n)
 	} else if s.gridSystem == "UTM" && s.northernHemisphere && s.zone == 30 {
-		gridSquare = s.getChannelIsleCode(code)
+		gridSquare = channelIslandSquares[[2]int{e, n}]
 	}
 
 	if gridSquare == "" {
@@ -58,318 +187,107 @@ func (s *gridReference) setGridReference() {
 	s.shortCode = fmt.Sprintf("%s%s", s.code[:5], s.code[7:10])
 }
 
-func (s *gridReference) getGbCode(code string) string {
-	switch code {
-	case "000012":
-		return "HL"
-	case "001012":
-		return "HM"
-	case "002012":
-		return "HN"
-	case "003012":
-		return "HO"
-	case "004012":
-		return "HP"
-	case "005012":
-		return "JL"
-	case "006012":
-		return "JM"
-	case "007012":
-		return "JN"
-
-	case "000011":
-		return "HQ"
-	case "001011":
-		return "HR"
-	case "002011":
-		return "HS"
-	case "003011":
-		return "HT"
-	case "004011":
-		return "HU"
-	case "005011":
-		return "JQ"
-	case "006011":
-		return "JR"
-	case "007011":
-		return "JS"
-
-	case "000010":
-		return "HV"
-	case "001010":
-		return "HW"
-	case "002010":
-		return "HX"
-	case "003010":
-		return "HY"
-	case "004010":
-		return "HZ"
-	case "005010":
-		return "JV"
-	case "006010":
-		return "JW"
-	case "007010":
-		return "JX"
-
-	case "000009":
-		return "NA"
-	case "001009":
-		return "NB"
-	case "002009":
-		return "NC"
-	case "003009":
-		return "ND"
-	case "004009":
-		return "NE"
-	case "005009":
-		return "OA"
-	case "006009":
-		return "OB"
-	case "007009":
-		return "OC"
-
-	case "000008":
-		return "NF"
-	case "001008":
-		return "NG"
-	case "002008":
-		return "NH"
-	case "003008":
-		return "NJ"
-	case "004008":
-		return "NK"
-	case "005008":
-		return "OF"
-	case "006008":
-		return "OG"
-	case "007008":
-		return "OH"
-
-	case "000007":
-		return "NL"
-	case "001007":
-		return "NM"
-	case "002007":
-		return "NN"
-	case "003007":
-		return "NO"
-	case "004007":
-		return "NP"
-	case "005007":
-		return "OL"
-	case "006007":
-		return "OM"
-	case "007007":
-		return "ON"
-
-	case "000006":
-		return "NQ"
-	case "001006":
-		return "NR"
-	case "002006":
-		return "NS"
-	case "003006":
-		return "NT"
-	case "004006":
-		return "NU"
-	case "005006":
-		return "OQ"
-	case "006006":
-		return "OR"
-	case "007006":
-		return "OS"
-
-	case "000005":
-		return "NV"
-	case "001005":
-		return "NW"
-	case "002005":
-		return "NX"
-	case "003005":
-		return "NY"
-	case "004005":
-		return "NZ"
-	case "005005":
-		return "OV"
-	case "006005":
-		return "OW"
-	case "007005":
-		return "OX"
-
-	case "000004":
-		return "SA"
-	case "001004":
-		return "SB"
-	case "002004":
-		return "SC"
-	case "003004":
-		return "SD"
-	case "004004":
-		return "SE"
-	case "005004":
-		return "TA"
-	case "006004":
-		return "TB"
-	case "007004":
-		return "TC"
-
-	case "000003":
-		return "SF"
-	case "001003":
-		return "SG"
-	case "002003":
-		return "SH"
-	case "003003":
-		return "SJ"
-	case "004003":
-		return "SK"
-	case "005003":
-		return "TF"
-	case "006003":
-		return "TG"
-	case "007003":
-		return "TH"
-
-	case "000002":
-		return "SL"
-	case "001002":
-		return "SM"
-	case "002002":
-		return "SN"
-	case "003002":
-		return "SO"
-	case "004002":
-		return "SP"
-	case "005002":
-		return "TL"
-	case "006002":
-		return "TM"
-	case "007002":
-		return "TN"
-
-	case "000001":
-		return "SQ"
-	case "001001":
-		return "SR"
-	case "002001":
-		return "SS"
-	case "003001":
-		return "ST"
-	case "004001":
-		return "SU"
-	case "005001":
-		return "TQ"
-	case "006001":
-		return "TR"
-	case "007001":
-		return "TS"
-
-	case "000000":
-		return "SV"
-	case "001000":
-		return "SW"
-	case "002000":
-		return "SX"
-	case "003000":
-		return "SY"
-	case "004000":
-		return "SZ"
-	case "005000":
-		return "TV"
-	case "006000":
-		return "TW"
-	case "007000":
-		return "TX"
-
-	default:
-		return ""
-	}
-}
-
-func (s *gridReference) getIeCode(code string) string {
-	switch code {
-	case "000004":
-		return "IA"
-	case "001004":
-		return "IB"
-	case "002004":
-		return "IC"
-	case "003004":
-		return "ID"
-	case "004004":
-		return "IE"
-	case "000003":
-		return "IF"
-	case "001003":
-		return "IG"
-	case "002003":
-		return "IH"
-	case "003003":
-		return "IJ"
-	case "004003":
-		return "IK"
-	case "000002":
-		return "IL"
-	case "001002":
-		return "IM"
-	case "002002":
-		return "IN"
-	case "003002":
-		return "IO"
-	case "004002":
-		return "IP"
-	case "000001":
-		return "IQ"
-	case "001001":
-		return "IR"
-	case "002001":
-		return "IS"
-	case "003001":
-		return "IT"
-	case "004001":
-		return "IU"
-	case "000000":
-		return "IV"
-	case "001000":
-		return "IW"
-	case "002000":
-		return "IX"
-	case "003000":
-		return "IY"
-	case "004000":
-		return "IZ"
-	default:
+// getGbCode returns the letters of the National Grid 100 km square with the
+// given easting and northing index. The first letter names the 500 km square,
+// counted from S at the false origin, and the second the 100 km square within
+// it.
+func getGbCode(e int, n int) string {
+	if e < 0 || e > 7 || n < 0 || n > 12 {
 		return ""
 	}
+	first := (19 - n) - (19-n)%5 + (e+10)/5
+	second := (19-n)*5%25 + e%5
+	return string([]byte{gridLetters[first], gridLetters[second]})
 }
 
-func (s *gridReference) getChannelIsleCode(code string) string {
-	switch code {
-	case "005054":
-		return "CJ"
-	case "005055":
-		return "CA"
-	default:
+// getIeCode returns the letters of the Irish Grid 100 km square with the
+// given easting and northing index, which all share the 500 km square I.
+func getIeCode(e int, n int) string {
+	if e < 0 || e > 4 || n < 0 || n > 4 {
 		return ""
 	}
+	return "I" + string(gridLetters[(4-n)*5+e])
 }
 
-func (s *gridReference) GetCode() string {
+// GetCode returns the grid square letters followed by a 5 digit easting and
+// northing, e.g. TQ2587571398.
+func (s *GridReference) GetCode() string {
 	return s.code
 }
 
-func (s *gridReference) GetShortCode() string {
+// GetShortCode returns the grid square letters followed by a 3 digit easting
+// and northing, e.g. TQ258713.
+func (s *GridReference) GetShortCode() string {
 	return s.shortCode
 }
 
-func (s *gridReference) GetEasting() float64 {
+// Format returns the grid square letters followed by the easting and
+// northing to the given total number of digits, which must be one of 2, 4,
+// 6, 8, 10 or 12, e.g. TQ 2587 7139 at 8 digits with spaces. Digits are
+// truncated, not rounded, so the reference names the square containing the
+// point. Only grids with lettered squares can be formatted.
+func (s *GridReference) Format(digits int, spaces bool) (string, error) {
+	if s.code == "" {
+		return "", errors.New("grid has no lettered squares")
+	}
+	if digits < 2 || digits > 12 || digits%2 != 0 {
+		return "", errors.New("digits must be 2, 4, 6, 8, 10 or 12")
+	}
+
+	precision := digits / 2
+	divisor := int(math.Pow(10, float64(6-precision)))
+	// Parsed decimetre references are not exact in floating point
+	easting := int(math.Floor(s.easting*10+1e-6)) % 1000000 / divisor
+	northing := int(math.Floor(s.northing*10+1e-6)) % 1000000 / divisor
+
+	separator := ""
+	if spaces {
+		separator = " "
+	}
+	return fmt.Sprintf(
+		"%s%s%0*d%s%0*d",
+		s.code[:2], separator, precision, easting, separator, precision, northing,
+	), nil
+}
+
+// GetEasting returns the easting in metres.
+func (s *GridReference) GetEasting() float64 {
 	return s.easting
 }
 
-func (s *gridReference) GetNorthing() float64 {
+// GetNorthing returns the northing in metres.
+func (s *GridReference) GetNorthing() float64 {
 	return s.northing
 }
 
-func (s *gridReference) GetZone() int {
+// GetZone returns the UTM or MGA zone, or 1 for grids without zones.
+func (s *GridReference) GetZone() int {
 	return s.zone
 }
+
+// GetGridSystem returns the name of the grid system, as passed to
+// GetGridReference.
+func (s *GridReference) GetGridSystem() string {
+	return s.gridSystem
+}
+
+// IsNorthernHemisphere reports whether a UTM reference is north of the
+// equator.
+func (s *GridReference) IsNorthernHemisphere() bool {
+	return s.northernHemisphere
+}
+
+// GetLatitude returns the WGS84 latitude of the referenced point.
+func (s *GridReference) GetLatitude() float64 {
+	return s.latitude
+}
+
+// GetLongitude returns the WGS84 longitude of the referenced point.
+func (s *GridReference) GetLongitude() float64 {
+	return s.longitude
+}
+
+// GetPoint returns the referenced point.
+func (s *GridReference) GetPoint() Point {
+	return New(s.latitude, s.longitude)
+}
@@ -0,0 +1,77 @@
+package coordinates
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+// Maidenhead locators divide the world into 18×18 fields of 20° by 10°,
+// each into 10×10 squares, 24×24 subsquares and 10×10 extended squares, so
+// at 8 characters a locator is 30″ of longitude by 15″ of latitude. The
+// arithmetic is done in those finest units.
+const (
+	maidenheadLongitudeUnits = 120 // per degree
+	maidenheadLatitudeUnits  = 240 // per degree
+)
+
+// GetMaidenhead returns the Maidenhead locator of the point to 2, 4, 6 or 8
+// characters, e.g. IO91wm45.
+func (s *Point) GetMaidenhead(characters int) (string, error) {
+	if characters < 2 || characters > 8 || characters%2 != 0 {
+		return "", errors.New("locator must have 2, 4, 6 or 8 characters")
+	}
+
+	x := int(math.Floor((s.longitude + 180) * maidenheadLongitudeUnits))
+	y := int(math.Floor((s.latitude + 90) * maidenheadLatitudeUnits))
+	x = int(math.Min(math.Max(float64(x), 0), 360*maidenheadLongitudeUnits-1))
+	y = int(math.Min(math.Max(float64(y), 0), 180*maidenheadLatitudeUnits-1))
+
+	locator := []byte{
+		byte('A' + x/2400), byte('A' + y/2400),
+		byte('0' + x/240%10), byte('0' + y/240%10),
+		byte('a' + x/10%24), byte('a' + y/10%24),
+		byte('0' + x%10), byte('0' + y%10),
+	}
+	return string(locator[:characters]), nil
+}
+
+// ParseMaidenhead returns the point at the centre of a 2, 4, 6 or 8
+// character Maidenhead locator.
+func ParseMaidenhead(locator string) (Point, error) {
+	locator = strings.ToUpper(strings.TrimSpace(locator))
+	if len(locator) < 2 || len(locator) > 8 || len(locator)%2 != 0 {
+		return Point{}, errors.New("locator must have 2, 4, 6 or 8 characters")
+	}
+
+	// Each pair gives the longitude and latitude steps within the previous
+	// pair, and how many of the finest units each step spans.
+	pairs := []struct {
+		first byte
+		last  byte
+		units int
+	}{
+		{'A', 'R', 2400},
+		{'0', '9', 240},
+		{'A', 'X', 10},
+		{'0', '9', 1},
+	}
+
+	x, y := 0, 0
+	span := 0
+	for i := 0; i < len(locator); i += 2 {
+		pair := pairs[i/2]
+		for _, c := range []byte{locator[i], locator[i+1]} {
+			if c < pair.first || c > pair.last {
+				return Point{}, errors.New("invalid locator")
+			}
+		}
+		x += int(locator[i]-pair.first) * pair.units
+		y += int(locator[i+1]-pair.first) * pair.units
+		span = pair.units
+	}
+
+	longitude := (float64(x)+float64(span)/2)/maidenheadLongitudeUnits - 180
+	latitude := (float64(y)+float64(span)/2)/maidenheadLatitudeUnits - 90
+	return New(latitude, longitude), nil
+}
@@ -0,0 +1,185 @@
+package coordinates
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	mgrsBands      = "CDEFGHJKLMNPQRSTUVWX"
+	mgrsRowLetters = "ABCDEFGHJKLMNPQRSTUV"
+)
+
+var mgrsColumnLetters = [3]string{"STUVWXYZ", "ABCDEFGH", "JKLMNPQR"}
+
+// mgrsZoneOverlap is how far, in degrees, ParseMGRS accepts a reference
+// beyond the edge of its zone, as when a country on a zone boundary keeps to
+// one zone throughout.
+const mgrsZoneOverlap = 0.5
+
+// latitudeBand returns the MGRS latitude band letter, or an empty string
+// outside the UTM latitudes of 80°S to 84°N. Band X is 12° tall.
+func latitudeBand(latitude float64) string {
+	if math.IsNaN(latitude) || latitude < -80 || latitude > 84 {
+		return ""
+	}
+	band := int(math.Floor((latitude + 80) / 8))
+	if band > len(mgrsBands)-1 {
+		band = len(mgrsBands) - 1
+	}
+	return mgrsBands[band : band+1]
+}
+
+// GetMGRS returns the Military Grid Reference System reference of the point
+// to 1 metre, e.g. 31U DQ 48251 11932, or an error in the polar regions
+// covered by UPS rather than UTM.
+func (s *Point) GetMGRS() (string, error) {
+	if math.IsNaN(s.longitude) || math.IsInf(s.longitude, 0) {
+		return "", errors.New("invalid coordinates")
+	}
+	band := latitudeBand(s.latitude)
+	if band == "" {
+		return "", errors.New("coordinates outside UTM")
+	}
+
+	zone := utmZone(s.latitude, s.longitude)
+	gridReference := s.GetUTMInZone(zone)
+	easting := int(math.Floor(gridReference.easting))
+	northing := int(math.Floor(gridReference.northing))
+
+	column := mgrsColumnLetters[zone%3][easting/100000-1]
+	row := northing / 100000 % 20
+	if zone%2 == 0 {
+		row = (row + 5) % 20
+	}
+
+	return fmt.Sprintf(
+		"%02d%s %c%c %05d %05d",
+		zone, band, column, mgrsRowLetters[row], easting%100000, northing%100000,
+	), nil
+}
+
+// GetLatitudeBand returns the MGRS latitude band letter of a UTM grid
+// reference, or an empty string for other grid systems.
+func (s *GridReference) GetLatitudeBand() string {
+	if s.gridSystem != "UTM" {
+		return ""
+	}
+	return latitudeBand(s.latitude)
+}
+
+// ParseMGRS returns the point referenced by an MGRS string such as
+// "31U DQ 48251 11932". Spaces are ignored and the digits may be given to
+// any even length up to 10; shorter references resolve to the south west
+// corner of the square they describe.
+func ParseMGRS(reference string) (Point, error) {
+	reference = strings.ToUpper(strings.Join(strings.Fields(reference), ""))
+
+	i := strings.IndexFunc(reference, func(r rune) bool { return r < '0' || r > '9' })
+	if i < 1 || i > 2 || len(reference) < i+3 {
+		return Point{}, errors.New("invalid MGRS reference")
+	}
+	zone, err := strconv.Atoi(reference[:i])
+	if err != nil || zone < 1 || zone > 60 {
+		return Point{}, errors.New("invalid MGRS zone")
+	}
+
+	band := strings.IndexByte(mgrsBands, reference[i])
+	column := strings.IndexByte(mgrsColumnLetters[zone%3], reference[i+1])
+	row := strings.IndexByte(mgrsRowLetters, reference[i+2])
+	if band < 0 || row < 0 {
+		return Point{}, errors.New("invalid MGRS letters")
+	}
+	if column < 0 {
+		return Point{}, fmt.Errorf("invalid MGRS column letter for zone %d", zone)
+	}
+
+	digits := reference[i+3:]
+	if len(digits)%2 != 0 || len(digits) > 10 {
+		return Point{}, errors.New("invalid MGRS digits")
+	}
+	if zone%2 == 0 {
+		row = (row + 15) % 20
+	}
+	easting := float64((column + 1) * 100000)
+	northing := float64(row * 100000)
+
+	precision := len(digits) / 2
+	scale := math.Pow(10, float64(5-precision))
+	if precision > 0 {
+		e, err := strconv.Atoi(digits[:precision])
+		if err != nil {
+			return Point{}, errors.New("invalid MGRS digits")
+		}
+		n, err := strconv.Atoi(digits[precision:])
+		if err != nil {
+			return Point{}, errors.New("invalid MGRS digits")
+		}
+		easting += float64(e) * scale
+		northing += float64(n) * scale
+	}
+
+	// The row letters repeat every 2000 km, so the northing is the first
+	// repeat above the bottom of the latitude band, less a margin for the
+	// curve of the band edge away from the central meridian.
+	bandLatitude := float64(band*8 - 80)
+	northernHemisphere := bandLatitude >= 0
+	datum := UtmNorth
+	if !northernHemisphere {
+		datum = UtmSouth
+	}
+	bottom := NewEastingsNorthings(bandLatitude, -3, datum)
+	bandNorthing := math.Floor(bottom.northing/100000)*100000 - 100000
+	for northing < bandNorthing {
+		northing += 2000000
+	}
+
+	point := NewFromUTM(zone, northernHemisphere, easting, northing)
+
+	// The reference resolves to the south west corner of its square, which
+	// may lie outside a zone or band the square only partly covers, so each
+	// edge is widened by the size of the square. Zones are widened further by
+	// mgrsZoneOverlap for references carried just across a zone edge.
+	bandTop := bandLatitude + 8
+	if mgrsBands[band] == 'X' {
+		bandTop = bandLatitude + 12
+	}
+	latitudeMargin := scale / 111320
+	if point.latitude < bandLatitude-latitudeMargin || point.latitude > bandTop+latitudeMargin {
+		return Point{}, errors.New("MGRS reference outside its latitude band")
+	}
+	west, east := zoneLongitudes(zone, mgrsBands[band])
+	longitudeMargin := scale / (111320 * math.Cos(degreesToRadians(point.latitude)))
+	longitudeMargin += mgrsZoneOverlap
+	if point.longitude < west-longitudeMargin || point.longitude > east+longitudeMargin {
+		return Point{}, errors.New("MGRS reference outside its zone")
+	}
+
+	return point, nil
+}
+
+// zoneLongitudes returns the western and eastern edges of a UTM zone in a
+// latitude band, allowing for the widened zones of Norway and Svalbard.
+func zoneLongitudes(zone int, band byte) (float64, float64) {
+	west := float64(zone*6 - 186)
+	east := west + 6
+	switch {
+	case band == 'V' && zone == 31:
+		east = 3
+	case band == 'V' && zone == 32:
+		west = 3
+	case band == 'X' && zone == 31:
+		east = 9
+	case band == 'X' && (zone == 33 || zone == 35):
+		west, east = west-3, east+3
+	case band == 'X' && zone == 37:
+		west = 33
+	case band == 'X' && (zone == 32 || zone == 34 || zone == 36):
+		// These zones are absorbed by their neighbours north of 72°N.
+		east = west
+	}
+	return west, east
+}
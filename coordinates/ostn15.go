@@ -0,0 +1,196 @@
+package coordinates
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+)
+
+const (
+	ostn15Columns = 701
+	ostn15Rows    = 1251
+	ostn15Spacing = 1000.0 // metres
+)
+
+// OSTN15 is the Ordnance Survey grid-shift model between ETRS89 and the
+// National Grid, loaded from the OSTN15_OSGM15_DataFile.txt published by OS.
+// It reproduces OSGB36 coordinates to about 0.1 m, where the Helmert
+// transformation used by the GB grid system is only good to a few metres.
+type OSTN15 struct {
+	eastShifts  []float64
+	northShifts []float64
+	covered     []bool
+}
+
+// ErrOSTN15Unavailable is returned for the OSTN15 grid system when no grid
+// has been embedded, set or named in OSTN15_DATA.
+var ErrOSTN15Unavailable = errors.New("OSTN15 data not available: build with -tags ostn15 or set OSTN15_DATA")
+
+var (
+	ostn15Mutex sync.Mutex
+	ostn15Grid  *OSTN15
+)
+
+// LoadOSTN15 reads an OSTN15 data file. Nodes missing from the file are
+// treated as outside the model's coverage.
+func LoadOSTN15(r io.Reader) (*OSTN15, error) {
+	nodes := ostn15Columns * ostn15Rows
+	grid := &OSTN15{
+		eastShifts:  make([]float64, nodes),
+		northShifts: make([]float64, nodes),
+		covered:     make([]bool, nodes),
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	line := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+		if len(record) < 5 {
+			return nil, fmt.Errorf("line %d: expected at least 5 columns", line)
+		}
+
+		id, err := strconv.Atoi(record[0])
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid point id", line)
+		}
+		if id < 1 || id > nodes {
+			return nil, fmt.Errorf("line %d: point id out of range", line)
+		}
+		east, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid easting shift", line)
+		}
+		north, err := strconv.ParseFloat(record[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid northing shift", line)
+		}
+
+		grid.eastShifts[id-1] = east
+		grid.northShifts[id-1] = north
+		grid.covered[id-1] = true
+	}
+
+	return grid, nil
+}
+
+// SetOSTN15 sets the grid used by the OSTN15 grid system, replacing any
+// embedded or previously loaded grid.
+func SetOSTN15(grid *OSTN15) {
+	ostn15Mutex.Lock()
+	defer ostn15Mutex.Unlock()
+	ostn15Grid = grid
+}
+
+// getOSTN15 returns the grid set with SetOSTN15 or embedded with the ostn15
+// build tag, otherwise loading it from the file named by OSTN15_DATA.
+func getOSTN15() (*OSTN15, error) {
+	ostn15Mutex.Lock()
+	defer ostn15Mutex.Unlock()
+	if ostn15Grid != nil {
+		return ostn15Grid, nil
+	}
+
+	path := os.Getenv("OSTN15_DATA")
+	if path == "" {
+		return nil, ErrOSTN15Unavailable
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	grid, err := LoadOSTN15(file)
+	if err != nil {
+		return nil, err
+	}
+	ostn15Grid = grid
+	return ostn15Grid, nil
+}
+
+// shift returns the easting and northing shifts at an ETRS89 easting and
+// northing by bilinear interpolation between the surrounding nodes.
+func (s *OSTN15) shift(easting float64, northing float64) (float64, float64, error) {
+	column := int(math.Floor(easting / ostn15Spacing))
+	row := int(math.Floor(northing / ostn15Spacing))
+	if column < 0 || column >= ostn15Columns-1 || row < 0 || row >= ostn15Rows-1 {
+		return 0, 0, errors.New("coordinates outside OSTN15")
+	}
+
+	nodes := [4]int{
+		column + row*ostn15Columns,
+		column + 1 + row*ostn15Columns,
+		column + 1 + (row+1)*ostn15Columns,
+		column + (row+1)*ostn15Columns,
+	}
+	for _, node := range nodes {
+		if !s.covered[node] {
+			return 0, 0, errors.New("coordinates outside OSTN15")
+		}
+	}
+
+	t := (easting - float64(column)*ostn15Spacing) / ostn15Spacing
+	u := (northing - float64(row)*ostn15Spacing) / ostn15Spacing
+	weights := [4]float64{(1 - t) * (1 - u), t * (1 - u), t * u, (1 - t) * u}
+
+	var east, north float64
+	for i, node := range nodes {
+		east += weights[i] * s.eastShifts[node]
+		north += weights[i] * s.northShifts[node]
+	}
+	return east, north, nil
+}
+
+// Transform returns the National Grid reference of the point, treating its
+// WGS84 coordinates as ETRS89.
+func (s *OSTN15) Transform(point *Point) (GridReference, error) {
+	etrs89 := NewEastingsNorthings(point.latitude, point.longitude, NationalGridETRS89)
+	east, north, err := s.shift(etrs89.easting, etrs89.northing)
+	if err != nil {
+		return GridReference{}, err
+	}
+
+	eastingNorthing := EastingNorthing{
+		easting:  etrs89.easting + east,
+		northing: etrs89.northing + north,
+	}
+	return NewGridReference(point, eastingNorthing, "OSTN15", 1, true), nil
+}
+
+// Inverse returns the point at a National Grid easting and northing. The
+// shifts depend on the ETRS89 position, so they are found by iteration.
+func (s *OSTN15) Inverse(easting float64, northing float64) (Point, error) {
+	etrs89 := EastingNorthing{easting: easting, northing: northing}
+	for i := 0; i < 10; i++ {
+		east, north, err := s.shift(etrs89.easting, etrs89.northing)
+		if err != nil {
+			return Point{}, err
+		}
+		next := EastingNorthing{easting: easting - east, northing: northing - north}
+		converged := math.Abs(next.easting-etrs89.easting) < 0.0001 && math.Abs(next.northing-etrs89.northing) < 0.0001
+		etrs89 = next
+		if converged {
+			break
+		}
+	}
+
+	latitude, longitude := etrs89.toLatitudeLongitude(NationalGridETRS89)
+	return New(latitude, longitude), nil
+}
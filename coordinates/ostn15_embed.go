@@ -0,0 +1,23 @@
+//go:build ostn15
+// +build ostn15
+
+package coordinates
+
+import (
+	"bytes"
+	_ "embed"
+)
+
+// Building with -tags ostn15 embeds the OS data file, which must first be
+// downloaded into this directory from the Ordnance Survey.
+//
+//go:embed OSTN15_OSGM15_DataFile.txt
+var ostn15Data []byte
+
+func init() {
+	grid, err := LoadOSTN15(bytes.NewReader(ostn15Data))
+	if err != nil {
+		panic(err)
+	}
+	ostn15Grid = grid
+}
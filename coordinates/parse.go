@@ -0,0 +1,202 @@
+package coordinates
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	mgrsPattern          = regexp.MustCompile(`^\d{1,2}[C-HJ-NP-X][A-HJ-NP-Z][A-HJ-NP-V]\d*$`)
+	utmPattern           = regexp.MustCompile(`^(\d{1,2})([C-HJ-NP-X])\s+(\d+(?:\.\d+)?)\s*M?E?\s+(\d+(?:\.\d+)?)\s*M?N?$`)
+	gridReferencePattern = regexp.MustCompile(`^[A-Z]{1,2}(\d\d)+$`)
+	maidenheadPattern    = regexp.MustCompile(`^[A-R]{2}\d{2}[A-X]{2}(?:\d{2})?$`)
+	geographicPattern    = regexp.MustCompile(`^(?:\s*(?:[NSEW]|-?\d+(?:\.\d+)?))+\s*$`)
+	geographicToken      = regexp.MustCompile(`[NSEW]|-?\d+(?:\.\d+)?`)
+)
+
+// geographicSymbols are the degree, minute and second marks, and the
+// separators, that may appear between the numbers of a latitude and
+// longitude. They carry no meaning once the numbers are counted.
+var geographicSymbols = strings.NewReplacer(
+	"°", " ", "º", " ", "˚", " ",
+	"′", " ", "'", " ", "’", " ",
+	"″", " ", "\"", " ", "”", " ",
+	",", " ", ";", " ", "/", " ",
+)
+
+// Parse returns the point described by a location in any of the common
+// formats pasted from maps, documents and GPS units:
+//
+//	59.3293, 18.0686             decimal degrees
+//	59°19′45″N 18°4′7″E          degrees, minutes and seconds
+//	N59 19.75 E18 04.12          degrees and decimal minutes
+//	TQ 30155 80412               GB, IE or Channel Islands grid reference
+//	33V 674031 6580807           UTM with latitude band
+//	33V XF 74031 80807           MGRS
+//	IO91wm                       6 or 8 character Maidenhead locator
+//
+// Latitude comes first unless the hemispheres say otherwise. Shorter
+// locators are not accepted, as IO91 is also an Irish grid reference.
+func Parse(location string) (Point, error) {
+	point, err := parse(location)
+	if err != nil {
+		return Point{}, err
+	}
+	if err := point.Validate(); err != nil {
+		return Point{}, err
+	}
+	return point, nil
+}
+
+func parse(location string) (Point, error) {
+	location = strings.ToUpper(strings.TrimSpace(location))
+	if location == "" {
+		return Point{}, errors.New("empty location")
+	}
+	compact := strings.Join(strings.Fields(location), "")
+
+	if mgrsPattern.MatchString(compact) {
+		return ParseMGRS(compact)
+	}
+	if match := utmPattern.FindStringSubmatch(location); match != nil {
+		return parseUTM(match)
+	}
+	if maidenheadPattern.MatchString(compact) {
+		return ParseMaidenhead(compact)
+	}
+	if gridReferencePattern.MatchString(compact) {
+		return NewFromGridReference(compact)
+	}
+	return parseGeographic(location)
+}
+
+func parseUTM(match []string) (Point, error) {
+	zone, _ := strconv.Atoi(match[1])
+	if zone < 1 || zone > 60 {
+		return Point{}, errors.New("invalid UTM zone")
+	}
+	easting, _ := strconv.ParseFloat(match[3], 64)
+	northing, _ := strconv.ParseFloat(match[4], 64)
+	point := NewFromUTM(zone, match[2] >= "N", easting, northing)
+
+	// Allow the band to be a few metres out, as the easting and northing are
+	// usually rounded.
+	band := match[2]
+	if latitudeBand(point.latitude) != band && latitudeBand(point.latitude-0.0001) != band && latitudeBand(point.latitude+0.0001) != band {
+		return Point{}, errors.New("UTM latitude band does not match the northing")
+	}
+	return point, nil
+}
+
+// parseGeographic reads a latitude and longitude written as one to three
+// numbers each, optionally marked with hemisphere letters before or after.
+func parseGeographic(location string) (Point, error) {
+	location = geographicSymbols.Replace(location)
+	if !geographicPattern.MatchString(location) {
+		return Point{}, errors.New("unrecognised location format")
+	}
+	tokens := geographicToken.FindAllString(location, -1)
+
+	var groups [][]string
+	var hemispheres []string
+	prefixed := isHemisphere(tokens[0])
+	current := []string{}
+	for _, token := range tokens {
+		if !isHemisphere(token) {
+			current = append(current, token)
+			continue
+		}
+		if prefixed {
+			if len(hemispheres) > 0 {
+				groups = append(groups, current)
+			}
+			current = []string{}
+		} else {
+			groups = append(groups, current)
+			current = []string{}
+		}
+		hemispheres = append(hemispheres, token)
+	}
+	if prefixed {
+		groups = append(groups, current)
+	} else if len(current) > 0 {
+		if len(hemispheres) > 0 {
+			return Point{}, errors.New("unrecognised location format")
+		}
+		if len(current)%2 != 0 {
+			return Point{}, errors.New("latitude and longitude must have the same precision")
+		}
+		groups = [][]string{current[:len(current)/2], current[len(current)/2:]}
+	}
+	if len(groups) != 2 || (len(hemispheres) != 0 && len(hemispheres) != 2) {
+		return Point{}, errors.New("location must have a latitude and a longitude")
+	}
+
+	values := [2]float64{}
+	for i, group := range groups {
+		value, err := parseAngle(group)
+		if err != nil {
+			return Point{}, err
+		}
+		values[i] = value
+	}
+
+	latitude, longitude := values[0], values[1]
+	if len(hemispheres) == 2 {
+		for i, hemisphere := range hemispheres {
+			if values[i] < 0 {
+				return Point{}, errors.New("hemisphere given with a negative angle")
+			}
+			if hemisphere == "S" || hemisphere == "W" {
+				values[i] = -values[i]
+			}
+		}
+		switch hemispheres[0] + hemispheres[1] {
+		case "NE", "NW", "SE", "SW":
+			latitude, longitude = values[0], values[1]
+		case "EN", "WN", "ES", "WS":
+			latitude, longitude = values[1], values[0]
+		default:
+			return Point{}, errors.New("location must have a latitude and a longitude")
+		}
+	}
+
+	return New(latitude, longitude), nil
+}
+
+// parseAngle combines degrees with optional minutes and seconds, taking the
+// sign from the degrees. Only the last number may have a fraction.
+func parseAngle(numbers []string) (float64, error) {
+	if len(numbers) < 1 || len(numbers) > 3 {
+		return 0, errors.New("unrecognised location format")
+	}
+
+	angle := 0.0
+	divisor := 1.0
+	negative := strings.HasPrefix(numbers[0], "-")
+	for i, number := range numbers {
+		value, err := strconv.ParseFloat(strings.TrimPrefix(number, "-"), 64)
+		if err != nil {
+			return 0, errors.New("unrecognised location format")
+		}
+		if i > 0 && (strings.HasPrefix(number, "-") || value >= 60) {
+			return 0, errors.New("minutes and seconds must be between 0 and 60")
+		}
+		if i < len(numbers)-1 && strings.Contains(number, ".") {
+			return 0, errors.New("only the last number of an angle may have a fraction")
+		}
+		angle += value / divisor
+		divisor *= 60
+	}
+
+	if negative {
+		return -angle, nil
+	}
+	return angle, nil
+}
+
+func isHemisphere(token string) bool {
+	return token == "N" || token == "S" || token == "E" || token == "W"
+}
@@ -0,0 +1,102 @@
+package coordinates
+
+import (
+	"errors"
+	"math"
+)
+
+// RD New (EPSG:28992) is computed with the polynomial approximation of
+// RDNAPTRANS published by Schreutelkamp and Strang van Hees, which folds the
+// Helmert transformation to Bessel 1841 and the oblique stereographic
+// projection into one series. It agrees with RDNAPTRANS to within 0.25 m
+// across the Netherlands.
+const (
+	rdOriginLatitude  = 52.15517440
+	rdOriginLongitude = 5.38720621
+	rdOriginEasting   = 155000.0
+	rdOriginNorthing  = 463000.0
+)
+
+// The polynomial is only fitted over the Netherlands, so points outside the
+// EPSG area of use of RD New are refused rather than extrapolated.
+const (
+	rdMinLatitude  = 50.75
+	rdMaxLatitude  = 53.7
+	rdMinLongitude = 3.2
+	rdMaxLongitude = 7.22
+)
+
+var errOutsideRD = errors.New("coordinates outside RD")
+
+func insideRD(latitude float64, longitude float64) bool {
+	return latitude >= rdMinLatitude && latitude <= rdMaxLatitude &&
+		longitude >= rdMinLongitude && longitude <= rdMaxLongitude
+}
+
+type rdTerm struct {
+	p           int
+	q           int
+	coefficient float64
+}
+
+var (
+	rdEastingTerms = []rdTerm{
+		{0, 1, 190094.945}, {1, 1, -11832.228}, {2, 1, -114.221},
+		{0, 3, -32.391}, {1, 0, -0.705}, {3, 1, -2.340},
+		{1, 3, -0.608}, {0, 2, -0.008}, {2, 3, 0.148},
+	}
+	rdNorthingTerms = []rdTerm{
+		{1, 0, 309056.544}, {0, 2, 3638.893}, {2, 0, 73.077},
+		{1, 2, -157.984}, {3, 0, 59.788}, {0, 1, 0.433},
+		{2, 2, -6.439}, {1, 1, -0.032}, {0, 4, 0.092},
+		{1, 4, -0.054},
+	}
+	rdLatitudeTerms = []rdTerm{
+		{0, 1, 3235.65389}, {2, 0, -32.58297}, {0, 2, -0.24750},
+		{2, 1, -0.84978}, {0, 3, -0.06550}, {2, 2, -0.01709},
+		{1, 0, -0.00738}, {4, 0, 0.00530}, {2, 3, -0.00039},
+		{4, 1, 0.00033}, {1, 1, -0.00012},
+	}
+	rdLongitudeTerms = []rdTerm{
+		{1, 0, 5260.52916}, {1, 1, 105.94684}, {1, 2, 2.45656},
+		{3, 0, -0.81885}, {1, 3, 0.05594}, {3, 1, -0.05607},
+		{0, 1, 0.01199}, {3, 2, -0.00256}, {1, 4, 0.00128},
+		{0, 2, 0.00022}, {2, 0, -0.00022}, {5, 0, 0.00026},
+	}
+)
+
+func rdSum(terms []rdTerm, x float64, y float64) float64 {
+	sum := 0.0
+	for _, t := range terms {
+		sum += t.coefficient * math.Pow(x, float64(t.p)) * math.Pow(y, float64(t.q))
+	}
+	return sum
+}
+
+// newRDEastingsNorthings returns the RD New coordinates of a WGS84 latitude
+// and longitude, or an error outside the Netherlands.
+func newRDEastingsNorthings(latitude float64, longitude float64) (EastingNorthing, error) {
+	if !insideRD(latitude, longitude) {
+		return EastingNorthing{}, errOutsideRD
+	}
+	dPhi := 0.36 * (latitude - rdOriginLatitude)
+	dLambda := 0.36 * (longitude - rdOriginLongitude)
+
+	return EastingNorthing{
+		easting:  rdOriginEasting + rdSum(rdEastingTerms, dPhi, dLambda),
+		northing: rdOriginNorthing + rdSum(rdNorthingTerms, dPhi, dLambda),
+	}, nil
+}
+
+// rdToLatitudeLongitude is the inverse of newRDEastingsNorthings.
+func (s *EastingNorthing) rdToLatitudeLongitude() (float64, float64, error) {
+	dX := (s.easting - rdOriginEasting) * 1e-5
+	dY := (s.northing - rdOriginNorthing) * 1e-5
+
+	latitude := rdOriginLatitude + rdSum(rdLatitudeTerms, dX, dY)/3600
+	longitude := rdOriginLongitude + rdSum(rdLongitudeTerms, dX, dY)/3600
+	if !insideRD(latitude, longitude) {
+		return 0, 0, errOutsideRD
+	}
+	return latitude, longitude, nil
+}
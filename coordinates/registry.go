@@ -0,0 +1,165 @@
+package coordinates
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// referenceSystem converts between WGS84 and the coordinates of one EPSG
+// coordinate reference system.
+type referenceSystem struct {
+	name      string
+	toPoint   func(x float64, y float64) (Point, error)
+	fromPoint func(point *Point) (float64, float64, error)
+}
+
+// ErrUnknownEPSG is returned for an EPSG code with no registered coordinate
+// reference system.
+var ErrUnknownEPSG = errors.New("unknown coordinate reference system")
+
+var (
+	registryMutex sync.RWMutex
+	datums        = map[int]Datum{}
+
+	// referenceSystems holds every system Transform accepts. Datums add
+	// themselves with RegisterDatum. ETRS89 is taken as WGS84, as it is
+	// throughout the package.
+	referenceSystems = map[int]referenceSystem{
+		4326:  {name: "WGS 84", toPoint: geographicToPoint, fromPoint: geographicFromPoint},
+		4258:  {name: "ETRS89", toPoint: geographicToPoint, fromPoint: geographicFromPoint},
+		28992: {name: "Amersfoort / RD New", toPoint: rdToPoint, fromPoint: rdFromPoint},
+	}
+)
+
+func geographicToPoint(latitude float64, longitude float64) (Point, error) {
+	return New(latitude, longitude), nil
+}
+
+func geographicFromPoint(point *Point) (float64, float64, error) {
+	return point.latitude, point.longitude, nil
+}
+
+func rdToPoint(easting float64, northing float64) (Point, error) {
+	eastingNorthing := EastingNorthing{easting: easting, northing: northing}
+	latitude, longitude, err := eastingNorthing.rdToLatitudeLongitude()
+	if err != nil {
+		return Point{}, err
+	}
+	return New(latitude, longitude), nil
+}
+
+func rdFromPoint(point *Point) (float64, float64, error) {
+	eastingNorthing, err := newRDEastingsNorthings(point.latitude, point.longitude)
+	if err != nil {
+		return 0, 0, err
+	}
+	return eastingNorthing.easting, eastingNorthing.northing, nil
+}
+
+// RegisterEllipsoid adds an ellipsoid under its EPSG code, for use by
+// DatumParameters.
+func RegisterEllipsoid(code int, name string, equatorialRadius float64, polarRadius float64) (Ellipsoid, error) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	if _, ok := ellipsoids[code]; ok {
+		return Ellipsoid{}, fmt.Errorf("ellipsoid EPSG:%d already registered", code)
+	}
+	ellipsoid := newEllipsoid(name, equatorialRadius, polarRadius)
+	ellipsoids[code] = ellipsoid
+	return ellipsoid, nil
+}
+
+// GetEllipsoid returns the ellipsoid registered under an EPSG code.
+func GetEllipsoid(code int) (Ellipsoid, error) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	ellipsoid, ok := ellipsoids[code]
+	if !ok {
+		return Ellipsoid{}, fmt.Errorf("unknown ellipsoid EPSG:%d", code)
+	}
+	return ellipsoid, nil
+}
+
+// RegisterDatum adds a grid system under the EPSG code of its projected
+// coordinate reference system, making it available to Transform.
+func RegisterDatum(code int, parameters DatumParameters) (Datum, error) {
+	datum, err := NewDatum(parameters)
+	if err != nil {
+		return Datum{}, err
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	if _, ok := referenceSystems[code]; ok {
+		return Datum{}, fmt.Errorf("EPSG:%d already registered", code)
+	}
+	datums[code] = datum
+	referenceSystems[code] = referenceSystem{
+		name: datum.name,
+		toPoint: func(easting float64, northing float64) (Point, error) {
+			return inverseTransform(EastingNorthing{easting: easting, northing: northing}, datum), nil
+		},
+		fromPoint: func(point *Point) (float64, float64, error) {
+			eastingNorthing := point.project(datum)
+			return eastingNorthing.easting, eastingNorthing.northing, nil
+		},
+	}
+	return datum, nil
+}
+
+// GetDatum returns the grid system registered under an EPSG code.
+func GetDatum(code int) (Datum, error) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	datum, ok := datums[code]
+	if !ok {
+		return Datum{}, fmt.Errorf("unknown datum EPSG:%d", code)
+	}
+	return datum, nil
+}
+
+func getReferenceSystem(code int) (referenceSystem, error) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	system, ok := referenceSystems[code]
+	if !ok {
+		return referenceSystem{}, fmt.Errorf("%w EPSG:%d", ErrUnknownEPSG, code)
+	}
+	return system, nil
+}
+
+// NewFromEPSG returns the Point at coordinates in the coordinate reference
+// system with the given EPSG code: latitude and longitude in degrees for
+// geographic systems, otherwise easting and northing in metres.
+func NewFromEPSG(code int, x float64, y float64) (Point, error) {
+	system, err := getReferenceSystem(code)
+	if err != nil {
+		return Point{}, err
+	}
+	return system.toPoint(x, y)
+}
+
+// GetEPSG returns the coordinates of the point in the coordinate reference
+// system with the given EPSG code, in the order NewFromEPSG takes them.
+func (s *Point) GetEPSG(code int) (float64, float64, error) {
+	system, err := getReferenceSystem(code)
+	if err != nil {
+		return 0, 0, err
+	}
+	x, y, err := system.fromPoint(s)
+	if err != nil {
+		return 0, 0, err
+	}
+	return x, y, nil
+}
+
+// Transform converts coordinates between two coordinate reference systems
+// given by EPSG code, through WGS84.
+func Transform(x float64, y float64, fromEPSG int, toEPSG int) (float64, float64, error) {
+	point, err := NewFromEPSG(fromEPSG, x, y)
+	if err != nil {
+		return 0, 0, err
+	}
+	return point.GetEPSG(toEPSG)
+}
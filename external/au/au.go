@@ -0,0 +1,81 @@
+package au
+
+// transmitters.csv holds the main DTV sites, typed by hand on 2026-10-19 from
+// the ACMA Register of Radiocommunications Licences at
+// https://www.acma.gov.au.
+//go:generate go run ../../cmd/rfxp-import -country au -in transmitters.csv -out transmitters.go -version 2026-10
+
+import (
+	"errors"
+	"math"
+
+	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/external"
+)
+
+type Australia struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Transmitter is a DTV site from the ACMA licence register, positioned on
+// the Map Grid of Australia in its own zone. Radius is the extent of the
+// licence area in kilometres.
+type Transmitter struct {
+	Name     string
+	Zone     int
+	Easting  float64
+	Northing float64
+	Radius   float64
+	Channels []int
+}
+
+func (s *Australia) GetCountryName() string {
+	return "Australia"
+}
+
+func (s *Australia) GetServiceName() string {
+	return "ACMA DTV Transmitter Licences"
+}
+
+func (s *Australia) GetGridSystem() string {
+	return "MGA"
+}
+
+func (s *Australia) Call() (*[]channel.Channel, error) {
+	if s.Latitude < -44 || s.Latitude > -10 || s.Longitude < 112 || s.Longitude > 154 {
+		return nil, errors.New("coordinates outside AU")
+	}
+
+	channels := s.channelsFromTransmitters(transmitters)
+	return channels, nil
+}
+
+func (s *Australia) channelsFromTransmitters(transmitters []Transmitter) *[]channel.Channel {
+	startFrequency := 526000
+	startChannel := 28
+	endChannel := 51
+	chWidth := 7000
+
+	channels := []channel.Channel{}
+	for ch := startChannel; ch <= endChannel; ch++ {
+		freqStart := startFrequency + (ch-startChannel)*chWidth
+		channels = append(channels, channel.Channel{
+			Number:    ch,
+			FreqStart: freqStart,
+			FreqEnd:   freqStart + chWidth,
+			Indoors:   true,
+			Outdoors:  true,
+		})
+	}
+
+	lookup := coordinates.New(s.Latitude, s.Longitude)
+	for _, t := range transmitters {
+		gridReference := lookup.GetMGAInZone(t.Zone)
+		distance := math.Hypot(gridReference.GetEasting()-t.Easting, gridReference.GetNorthing()-t.Northing) / 1000
+		external.BlockChannels(channels, t.Channels, distance, t.Radius)
+	}
+
+	return &channels
+}
@@ -0,0 +1,177 @@
+package au_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/external/au"
+)
+
+func TestValidAu(t *testing.T) {
+	type TestChannel struct {
+		Channel  int
+		Indoors  bool
+		Outdoors bool
+	}
+
+	type TestCase struct {
+		PlaceName string
+		Latitude  float64
+		Longitude float64
+		Channels  []TestChannel
+	}
+
+	testCases := []TestCase{
+		{
+			PlaceName: "Sydney",
+			Latitude:  -33.868800,
+			Longitude: 151.209300,
+			Channels: []TestChannel{
+				{Channel: 28, Indoors: false, Outdoors: false},
+				{Channel: 29, Indoors: false, Outdoors: false},
+				{Channel: 30, Indoors: false, Outdoors: false},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: false, Outdoors: false},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: false, Outdoors: false},
+				{Channel: 35, Indoors: false, Outdoors: false},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: true, Outdoors: true},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+				{Channel: 49, Indoors: true, Outdoors: true},
+				{Channel: 50, Indoors: true, Outdoors: true},
+				{Channel: 51, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Wollongong",
+			Latitude:  -34.427800,
+			Longitude: 150.893100,
+			Channels: []TestChannel{
+				{Channel: 28, Indoors: true, Outdoors: false},
+				{Channel: 29, Indoors: true, Outdoors: false},
+				{Channel: 30, Indoors: true, Outdoors: false},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: false},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: true, Outdoors: false},
+				{Channel: 35, Indoors: true, Outdoors: false},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: true, Outdoors: true},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: false, Outdoors: false},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: false, Outdoors: false},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: false, Outdoors: false},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: false, Outdoors: false},
+				{Channel: 48, Indoors: true, Outdoors: true},
+				{Channel: 49, Indoors: false, Outdoors: false},
+				{Channel: 50, Indoors: false, Outdoors: false},
+				{Channel: 51, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Alice Springs",
+			Latitude:  -23.698000,
+			Longitude: 133.880700,
+			Channels: []TestChannel{
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: true, Outdoors: true},
+				{Channel: 30, Indoors: true, Outdoors: true},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: true},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: true, Outdoors: true},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+				{Channel: 49, Indoors: true, Outdoors: true},
+				{Channel: 50, Indoors: true, Outdoors: true},
+				{Channel: 51, Indoors: true, Outdoors: true},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		s := au.Australia{Latitude: test.Latitude, Longitude: test.Longitude}
+		c, err := s.Call()
+		if err != nil {
+			log.Fatalf("unexpected error looking up %s", test.PlaceName)
+		}
+		channels := *c
+
+		if len(channels) != len(test.Channels) {
+			log.Fatalf("wrong number of channels in %s... expected %d, got %d", test.PlaceName, len(test.Channels), len(channels))
+		}
+		for i := 0; i < len(channels); i++ {
+			if channels[i].FreqEnd-channels[i].FreqStart != 7000 {
+				log.Fatalf("invalid channel width in %s channel %d", test.PlaceName, channels[i].Number)
+			}
+			if channels[i].Number != test.Channels[i].Channel {
+				log.Fatalf("invalid channel in %s... expected %d, got %d", test.PlaceName, test.Channels[i].Channel, channels[i].Number)
+			}
+			if channels[i].Indoors != test.Channels[i].Indoors {
+				log.Fatalf("invalid indoors availability in %s channel %d... expected %v, got %v", test.PlaceName, test.Channels[i].Channel, test.Channels[i].Indoors, channels[i].Indoors)
+			}
+			if channels[i].Outdoors != test.Channels[i].Outdoors {
+				log.Fatalf("invalid outdoors availability in %s channel %d... expected %v, got %v", test.PlaceName, test.Channels[i].Channel, test.Channels[i].Outdoors, channels[i].Outdoors)
+			}
+		}
+	}
+}
+
+func TestInvalidAu(t *testing.T) {
+	s := au.Australia{
+		Latitude:  48.856613,
+		Longitude: 2.352222,
+	}
+	_, err := s.Call()
+	if err == nil {
+		log.Fatalf("expected error for location outside AU")
+	}
+}
+
+func TestName(t *testing.T) {
+	s := au.Australia{
+		Latitude:  -33.868800,
+		Longitude: 151.209300,
+	}
+	name := s.GetCountryName()
+	if name != "Australia" {
+		log.Fatalf("got wrong country name")
+	}
+}
+
+func TestService(t *testing.T) {
+	s := au.Australia{
+		Latitude:  -33.868800,
+		Longitude: 151.209300,
+	}
+	name := s.GetServiceName()
+	if name != "ACMA DTV Transmitter Licences" {
+		log.Fatalf("got wrong service name")
+	}
+}
@@ -0,0 +1,19 @@
+// Code generated by rfxp-import; DO NOT EDIT.
+
+package au
+
+const DatasetVersion = "2026-10"
+
+var transmitters = []Transmitter{
+	{Name: "Sydney Artarmon", Zone: 56, Easting: 331895, Northing: 6256352, Radius: 70, Channels: []int{28, 29, 30, 32, 34, 35}},
+	{Name: "Melbourne Mount Dandenong", Zone: 55, Easting: 355157, Northing: 5811215, Radius: 70, Channels: []int{28, 29, 30, 32, 35, 36}},
+	{Name: "Brisbane Mount Coot-tha", Zone: 56, Easting: 495060, Northing: 6961727, Radius: 65, Channels: []int{28, 30, 31, 33, 35, 37}},
+	{Name: "Adelaide Mount Lofty", Zone: 54, Easting: 290867, Northing: 6126999, Radius: 60, Channels: []int{28, 30, 31, 34, 36, 37}},
+	{Name: "Perth Kings Park", Zone: 50, Easting: 389433, Northing: 6463622, Radius: 55, Channels: []int{28, 29, 30, 33, 35, 39}},
+	{Name: "Hobart Mount Wellington", Zone: 55, Easting: 519350, Northing: 5250707, Radius: 50, Channels: []int{29, 31, 33, 35, 37, 40}},
+	{Name: "Canberra Black Mountain", Zone: 55, Easting: 690727, Northing: 6094444, Radius: 45, Channels: []int{34, 37, 40, 43, 46, 48}},
+	{Name: "Darwin Palmerston", Zone: 52, Easting: 715191, Northing: 8619564, Radius: 40, Channels: []int{29, 30, 32, 34, 36, 38}},
+	{Name: "Newcastle Mount Sugarloaf", Zone: 56, Easting: 363438, Northing: 6359963, Radius: 60, Channels: []int{37, 38, 39, 40, 42, 44}},
+	{Name: "Wollongong Knights Hill", Zone: 56, Easting: 288218, Northing: 6166670, Radius: 45, Channels: []int{41, 43, 45, 47, 49, 50}},
+	{Name: "Gold Coast Mount Tamborine", Zone: 56, Easting: 518688, Northing: 6907214, Radius: 45, Channels: []int{39, 41, 43, 45, 47, 50}},
+}
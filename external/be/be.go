@@ -0,0 +1,68 @@
+package be
+
+// transmitters.csv holds the main DTT sites, typed by hand on 2026-10-19 from
+// the BIPT broadcasting data published at https://www.bipt.be.
+//go:generate go run ../../cmd/rfxp-import -country be -in transmitters.csv -out transmitters.go -version 2026-10
+
+import (
+	"errors"
+	"math"
+
+	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/external"
+)
+
+// Belgium looks up a location on Belgian Lambert 2008. EllipsoidHeight is
+// the height above the WGS84 ellipsoid used in the datum transformation.
+type Belgium struct {
+	Latitude        float64
+	Longitude       float64
+	EllipsoidHeight float64
+}
+
+// Transmitter is a DTT site from the BIPT frequency plan, positioned on the
+// Belgian Lambert 2008 grid. Radius is the extent of the site's service area
+// in kilometres.
+type Transmitter struct {
+	Name     string
+	Easting  float64
+	Northing float64
+	Radius   float64
+	Channels []int
+}
+
+func (s *Belgium) GetCountryName() string {
+	return "Belgium"
+}
+
+func (s *Belgium) GetServiceName() string {
+	return "BIPT Wireless Microphones"
+}
+
+func (s *Belgium) GetGridSystem() string {
+	return "BE08"
+}
+
+func (s *Belgium) Call() (*[]channel.Channel, error) {
+	if s.Latitude < 49.45 || s.Latitude > 51.55 || s.Longitude < 2.5 || s.Longitude > 6.45 {
+		return nil, errors.New("coordinates outside BE")
+	}
+
+	channels := s.channelsFromTransmitters(transmitters)
+	return channels, nil
+}
+
+func (s *Belgium) channelsFromTransmitters(transmitters []Transmitter) *[]channel.Channel {
+	// Channel 38 is reserved for radio astronomy.
+	channels := external.UHFChannels(38)
+
+	lookup := coordinates.NewWithHeight(s.Latitude, s.Longitude, s.EllipsoidHeight)
+	gridReference, _ := lookup.GetGridReference("BE08")
+	for _, t := range transmitters {
+		distance := math.Hypot(gridReference.GetEasting()-t.Easting, gridReference.GetNorthing()-t.Northing) / 1000
+		external.BlockChannels(channels, t.Channels, distance, t.Radius)
+	}
+
+	return &channels
+}
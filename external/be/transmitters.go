@@ -0,0 +1,17 @@
+// Code generated by rfxp-import; DO NOT EDIT.
+
+package be
+
+const DatasetVersion = "2026-10"
+
+var transmitters = []Transmitter{
+	{Name: "Brussel Reyers", Easting: 652481, Northing: 671502, Radius: 35, Channels: []int{22, 27, 33, 40, 45}},
+	{Name: "Sint-Pieters-Leeuw", Easting: 639743, Northing: 663445, Radius: 40, Channels: []int{24, 29, 31, 37, 43, 47}},
+	{Name: "Egem", Easting: 572668, Northing: 690175, Radius: 40, Channels: []int{21, 26, 32, 36, 41, 46}},
+	{Name: "Schoten", Easting: 659331, Northing: 716466, Radius: 35, Channels: []int{23, 28, 34, 39, 44}},
+	{Name: "Genk", Easting: 731077, Northing: 685925, Radius: 35, Channels: []int{25, 30, 35, 38, 42, 48}},
+	{Name: "Wavre", Easting: 666332, Northing: 656267, Radius: 30, Channels: []int{22, 27, 33, 40, 45}},
+	{Name: "Liège Sart-Tilman", Easting: 734838, Northing: 642096, Radius: 35, Channels: []int{21, 26, 31, 36, 43}},
+	{Name: "Anlier", Easting: 741112, Northing: 551359, Radius: 40, Channels: []int{24, 28, 34, 39, 46}},
+	{Name: "Tournai Froyennes", Easting: 577933, Northing: 647447, Radius: 35, Channels: []int{23, 29, 32, 37, 44}},
+}
@@ -0,0 +1,57 @@
+// Package external holds what the national providers share: the UHF channel
+// plan and the filter that clears channels around transmitter sites.
+package external
+
+import "github.com/stebunting/rfxp-backend/channel"
+
+// BorderZone is how many kilometres beyond the edge of a site's service area
+// its channels stay usable indoors, but not outdoors.
+const BorderZone = 20.0
+
+// UHFChannels returns channels 21 to 48 (470-694 MHz), free indoors and
+// outdoors apart from the reserved channels, which are blocked in both.
+func UHFChannels(reserved ...int) []channel.Channel {
+	startChannel := 21
+	endChannel := 48
+	startFrequency := 470000
+	chWidth := 8000
+
+	channels := []channel.Channel{}
+	for ch := startChannel; ch <= endChannel; ch++ {
+		freqStart := startFrequency + (ch-startChannel)*chWidth
+		channels = append(channels, channel.Channel{
+			Number:    ch,
+			FreqStart: freqStart,
+			FreqEnd:   freqStart + chWidth,
+			Indoors:   true,
+			Outdoors:  true,
+		})
+	}
+	for _, ch := range reserved {
+		if ch >= startChannel && ch <= endChannel {
+			channels[ch-startChannel].Indoors = false
+			channels[ch-startChannel].Outdoors = false
+		}
+	}
+	return channels
+}
+
+// BlockChannels clears the channels a site occupies for a location distance
+// kilometres from it. Inside the site's service area of the given radius
+// they are blocked entirely, and within BorderZone of its edge outdoors
+// only. channels must be consecutive, starting from the first channel.
+func BlockChannels(channels []channel.Channel, occupied []int, distance float64, radius float64) {
+	if len(channels) == 0 || distance > radius+BorderZone {
+		return
+	}
+
+	startChannel := channels[0].Number
+	for _, ch := range occupied {
+		index := ch - startChannel
+		if index < 0 || index >= len(channels) {
+			continue
+		}
+		channels[index].Indoors = channels[index].Indoors && distance > radius
+		channels[index].Outdoors = false
+	}
+}
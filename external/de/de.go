@@ -0,0 +1,60 @@
+package de
+
+// transmitters.csv holds the main DVB-T2 sites, typed by hand on 2026-10-19
+// from the BNetzA frequency assignment tables published at
+// https://www.bundesnetzagentur.de.
+//go:generate go run ../../cmd/rfxp-import -country de -in transmitters.csv -out transmitters.go -version 2026-10
+
+import (
+	"errors"
+
+	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/external"
+)
+
+type Germany struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Transmitter is a DVB-T2 site from the BNetzA allocation tables. Radius is
+// the extent of the site's service area in kilometres.
+type Transmitter struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Radius    float64
+	Channels  []int
+}
+
+func (s *Germany) GetCountryName() string {
+	return "Germany"
+}
+
+func (s *Germany) GetServiceName() string {
+	return "BNetzA Allgemeinzuteilung Funkmikrofone"
+}
+
+func (s *Germany) Call() (*[]channel.Channel, error) {
+	if s.Latitude < 47.2 || s.Latitude > 55.1 || s.Longitude < 5.8 || s.Longitude > 15.1 {
+		return nil, errors.New("coordinates outside DE")
+	}
+
+	channels := s.channelsFromTransmitters(transmitters)
+	return channels, nil
+}
+
+func (s *Germany) channelsFromTransmitters(transmitters []Transmitter) *[]channel.Channel {
+	// Channel 38 is reserved for radio astronomy and is not part of the
+	// general assignment.
+	channels := external.UHFChannels(38)
+
+	lookup := coordinates.New(s.Latitude, s.Longitude)
+	for _, t := range transmitters {
+		distance := lookup.DistanceTo(t.Latitude, t.Longitude) / 1000
+		external.BlockChannels(channels, t.Channels, distance, t.Radius)
+	}
+
+	return &channels
+}
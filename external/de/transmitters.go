@@ -0,0 +1,24 @@
+// Code generated by rfxp-import; DO NOT EDIT.
+
+package de
+
+const DatasetVersion = "2026-10"
+
+var transmitters = []Transmitter{
+	{Name: "Berlin Alexanderplatz", Latitude: 52.520803, Longitude: 13.409416, Radius: 60, Channels: []int{25, 29, 33, 35, 39, 46}},
+	{Name: "Hamburg Heinrich-Hertz-Turm", Latitude: 53.562914, Longitude: 9.976002, Radius: 60, Channels: []int{22, 27, 31, 34, 41, 44}},
+	{Name: "Hannover Telemax", Latitude: 52.389167, Longitude: 9.8025, Radius: 55, Channels: []int{24, 28, 30, 37, 40, 43}},
+	{Name: "Bremen Walle", Latitude: 53.0975, Longitude: 8.768611, Radius: 45, Channels: []int{22, 27, 31, 34, 41, 44}},
+	{Name: "Köln Colonius", Latitude: 50.946944, Longitude: 6.931944, Radius: 50, Channels: []int{21, 26, 32, 36, 42, 47}},
+	{Name: "Düsseldorf Rheinturm", Latitude: 51.217917, Longitude: 6.761667, Radius: 45, Channels: []int{23, 26, 32, 36, 42, 47}},
+	{Name: "Dortmund Florianturm", Latitude: 51.494722, Longitude: 7.469444, Radius: 50, Channels: []int{21, 23, 30, 36, 40, 45}},
+	{Name: "Frankfurt Großer Feldberg", Latitude: 50.232222, Longitude: 8.457778, Radius: 65, Channels: []int{24, 28, 33, 37, 39, 43}},
+	{Name: "Stuttgart Frauenkopf", Latitude: 48.764167, Longitude: 9.225833, Radius: 55, Channels: []int{21, 25, 31, 35, 44, 46}},
+	{Name: "München Olympiaturm", Latitude: 48.174167, Longitude: 11.553611, Radius: 50, Channels: []int{22, 26, 29, 34, 41, 45}},
+	{Name: "Wendelstein", Latitude: 47.703611, Longitude: 12.012222, Radius: 70, Channels: []int{23, 27, 30, 39, 41, 45}},
+	{Name: "Nürnberg Dillberg", Latitude: 49.320833, Longitude: 11.383333, Radius: 60, Channels: []int{24, 28, 32, 37, 40, 47}},
+	{Name: "Leipzig Wiederitzsch", Latitude: 51.396944, Longitude: 12.386111, Radius: 55, Channels: []int{22, 26, 31, 35, 39, 44}},
+	{Name: "Dresden Wachwitz", Latitude: 51.038611, Longitude: 13.837222, Radius: 50, Channels: []int{23, 27, 30, 34, 42, 46}},
+	{Name: "Kiel Fernmeldeturm", Latitude: 54.313611, Longitude: 10.101944, Radius: 45, Channels: []int{21, 25, 32, 36, 43, 47}},
+	{Name: "Rostock Toitenwinkel", Latitude: 54.114722, Longitude: 12.183611, Radius: 50, Channels: []int{24, 29, 33, 37, 40, 44}},
+}
@@ -15,6 +15,12 @@ import (
 type Denmark struct {
 	Latitude  float64
 	Longitude float64
+
+	// easting and northing are the ETRS89 UTM zone 32 coordinates the
+	// Energistyrelsen API returned for the last call.
+	easting     float64
+	northing    float64
+	hasGridData bool
 }
 
 type ApiResponse struct {
@@ -39,6 +45,16 @@ func (s *Denmark) GetServiceName() string {
 	return "Energistyrelsen"
 }
 
+func (s *Denmark) GetGridSystem() string {
+	return "ETRS89UTM32"
+}
+
+// GetGridCoordinates returns the easting and northing the API reported for
+// the location, and false when it did not report any.
+func (s *Denmark) GetGridCoordinates() (float64, float64, bool) {
+	return s.easting, s.northing, s.hasGridData
+}
+
 func (s *Denmark) Call() (*[]channel.Channel, error) {
 	result, err := s.makeApiCall()
 	if err != nil {
@@ -95,6 +111,12 @@ func (s *Denmark) makeApiCall() (*[][]int, error) {
 		return nil, errors.New(response.Status)
 	}
 
+	if len(response.NorthingsEastings) == 2 {
+		s.northing = float64(response.NorthingsEastings[0])
+		s.easting = float64(response.NorthingsEastings[1])
+		s.hasGridData = true
+	}
+
 	return &response.Results[0].TvChannelsNoGuardBand, nil
 }
 
@@ -0,0 +1,52 @@
+package fallback
+
+import (
+	"errors"
+
+	"github.com/stebunting/rfxp-backend/channel"
+)
+
+// Fallback serves countries without a supported national database. It
+// returns the CEPT/ECC harmonised PMSE baseline with every channel marked
+// unverified, since no regulator has been consulted for the location.
+type Fallback struct {
+	Code string
+}
+
+var ceptMembers = map[string]bool{
+	"AD": true, "AL": true, "AT": true, "AZ": true, "BA": true, "BE": true,
+	"BG": true, "CH": true, "CY": true, "CZ": true, "DE": true, "DK": true,
+	"EE": true, "ES": true, "FI": true, "FR": true, "GB": true, "GE": true,
+	"GR": true, "HR": true, "HU": true, "IE": true, "IS": true, "IT": true,
+	"LI": true, "LT": true, "LU": true, "LV": true, "MC": true, "MD": true,
+	"ME": true, "MK": true, "MT": true, "NL": true, "NO": true, "PL": true,
+	"PT": true, "RO": true, "RS": true, "SE": true, "SI": true, "SK": true,
+	"SM": true, "TR": true, "UA": true, "VA": true,
+}
+
+func (s *Fallback) GetCountryName() string {
+	return "Unknown"
+}
+
+func (s *Fallback) GetServiceName() string {
+	return "CEPT/ECC Harmonised Baseline"
+}
+
+// Call returns the 800 MHz and 1800 MHz duplex gaps and the 863-865 MHz
+// licence-exempt band, with channel 38 reserved for radio astronomy as in
+// the national providers. Ranges that are not TV channels have channel
+// number 0. The baseline only holds in CEPT administrations, so elsewhere
+// Call returns no channels and an error.
+func (s *Fallback) Call() (*[]channel.Channel, error) {
+	if !ceptMembers[s.Code] {
+		return &[]channel.Channel{}, errors.New("no harmonised baseline outside CEPT administrations")
+	}
+
+	channels := []channel.Channel{
+		{Number: 38, FreqStart: 606000, FreqEnd: 614000, Indoors: false, Outdoors: false, Unverified: true},
+		{Number: 0, FreqStart: 823000, FreqEnd: 832000, Indoors: true, Outdoors: true, Unverified: true},
+		{Number: 0, FreqStart: 863000, FreqEnd: 865000, Indoors: true, Outdoors: true, Unverified: true},
+		{Number: 0, FreqStart: 1785000, FreqEnd: 1805000, Indoors: true, Outdoors: true, Unverified: true},
+	}
+	return &channels, nil
+}
@@ -0,0 +1,85 @@
+package fallback_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/external/fallback"
+)
+
+func TestFallback(t *testing.T) {
+	type TestChannel struct {
+		Number    int
+		FreqStart int
+		FreqEnd   int
+		Available bool
+	}
+
+	type TestCase struct {
+		Code     string
+		Channels []TestChannel
+	}
+
+	testCases := []TestCase{
+		{
+			Code: "AT",
+			Channels: []TestChannel{
+				{Number: 38, FreqStart: 606000, FreqEnd: 614000, Available: false},
+				{Number: 0, FreqStart: 823000, FreqEnd: 832000, Available: true},
+				{Number: 0, FreqStart: 863000, FreqEnd: 865000, Available: true},
+				{Number: 0, FreqStart: 1785000, FreqEnd: 1805000, Available: true},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		s := &fallback.Fallback{Code: test.Code}
+		c, err := s.Call()
+		if err != nil {
+			log.Fatalf("unexpected error calling fallback")
+		}
+		channels := *c
+
+		if len(channels) != len(test.Channels) {
+			log.Fatalf("wrong number of channels for %s... expected %d, got %d", test.Code, len(test.Channels), len(channels))
+		}
+		for i := 0; i < len(channels); i++ {
+			if channels[i].Number != test.Channels[i].Number || channels[i].FreqStart != test.Channels[i].FreqStart || channels[i].FreqEnd != test.Channels[i].FreqEnd {
+				log.Fatalf("invalid channel for %s... expected %v, got %v", test.Code, test.Channels[i], channels[i])
+			}
+			if channels[i].Indoors != test.Channels[i].Available || channels[i].Outdoors != test.Channels[i].Available {
+				log.Fatalf("wrong availability for channel %d for %s... expected %t", channels[i].FreqStart, test.Code, test.Channels[i].Available)
+			}
+			if !channels[i].Unverified {
+				log.Fatalf("channel %d for %s not marked unverified", channels[i].FreqStart, test.Code)
+			}
+		}
+	}
+}
+
+func TestOutsideCEPT(t *testing.T) {
+	s := &fallback.Fallback{Code: "ZA"}
+	c, err := s.Call()
+	if err == nil {
+		log.Fatalf("expected error calling fallback outside CEPT")
+	}
+	if c == nil || len(*c) != 0 {
+		log.Fatalf("expected no channels outside CEPT")
+	}
+}
+
+func TestName(t *testing.T) {
+	s := &fallback.Fallback{Code: "AT"}
+	name := s.GetCountryName()
+	if name != "Unknown" {
+		log.Fatalf("got wrong country name")
+	}
+}
+
+func TestService(t *testing.T) {
+	s := &fallback.Fallback{Code: "AT"}
+	name := s.GetServiceName()
+	if name != "CEPT/ECC Harmonised Baseline" {
+		log.Fatalf("got wrong service name")
+	}
+}
@@ -0,0 +1,60 @@
+package fi
+
+// transmitters.csv holds the main DTT sites, typed by hand on 2026-10-19 from
+// the regional DTT channel usage Traficom publishes at
+// https://www.traficom.fi.
+//go:generate go run ../../cmd/rfxp-import -country fi -in transmitters.csv -out transmitters.go -version 2026-10
+
+import (
+	"errors"
+
+	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/external"
+)
+
+type Finland struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Transmitter is a regional DTT site from Traficom's channel usage tables.
+// Radius is the extent of the site's service area in kilometres.
+type Transmitter struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Radius    float64
+	Channels  []int
+}
+
+func (s *Finland) GetCountryName() string {
+	return "Finland"
+}
+
+func (s *Finland) GetServiceName() string {
+	return "Traficom Licence-exempt Wireless Microphones"
+}
+
+func (s *Finland) Call() (*[]channel.Channel, error) {
+	if s.Latitude < 59.7 || s.Latitude > 70.1 || s.Longitude < 19.0 || s.Longitude > 31.6 {
+		return nil, errors.New("coordinates outside FI")
+	}
+
+	channels := s.channelsFromTransmitters(transmitters)
+	return channels, nil
+}
+
+func (s *Finland) channelsFromTransmitters(transmitters []Transmitter) *[]channel.Channel {
+	// Wireless microphones are licence-exempt across 470-694 MHz wherever
+	// the channel is not in use for DTT.
+	channels := external.UHFChannels()
+
+	lookup := coordinates.New(s.Latitude, s.Longitude)
+	for _, t := range transmitters {
+		distance := lookup.DistanceTo(t.Latitude, t.Longitude) / 1000
+		external.BlockChannels(channels, t.Channels, distance, t.Radius)
+	}
+
+	return &channels
+}
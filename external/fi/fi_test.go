@@ -0,0 +1,220 @@
+package fi_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/external/fi"
+)
+
+func TestValidFi(t *testing.T) {
+	type TestChannel struct {
+		Channel  int
+		Indoors  bool
+		Outdoors bool
+	}
+
+	type TestCase struct {
+		PlaceName string
+		Latitude  float64
+		Longitude float64
+		Channels  []TestChannel
+	}
+
+	testCases := []TestCase{
+		{
+			PlaceName: "Helsinki",
+			Latitude:  60.169900,
+			Longitude: 24.938400,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: true, Outdoors: true},
+				{Channel: 22, Indoors: true, Outdoors: true},
+				{Channel: 23, Indoors: true, Outdoors: true},
+				{Channel: 24, Indoors: false, Outdoors: false},
+				{Channel: 25, Indoors: true, Outdoors: true},
+				{Channel: 26, Indoors: true, Outdoors: true},
+				{Channel: 27, Indoors: false, Outdoors: false},
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: true, Outdoors: true},
+				{Channel: 30, Indoors: false, Outdoors: false},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: true},
+				{Channel: 33, Indoors: false, Outdoors: false},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: false, Outdoors: false},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: true, Outdoors: true},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: false, Outdoors: false},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: false, Outdoors: false},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: false, Outdoors: false},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Hanko",
+			Latitude:  59.823600,
+			Longitude: 22.968100,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: true, Outdoors: false},
+				{Channel: 22, Indoors: true, Outdoors: true},
+				{Channel: 23, Indoors: true, Outdoors: true},
+				{Channel: 24, Indoors: true, Outdoors: true},
+				{Channel: 25, Indoors: true, Outdoors: false},
+				{Channel: 26, Indoors: true, Outdoors: true},
+				{Channel: 27, Indoors: true, Outdoors: true},
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: true, Outdoors: false},
+				{Channel: 30, Indoors: true, Outdoors: true},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: true},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: false},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: true, Outdoors: false},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: true, Outdoors: false},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: false},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Kuopio",
+			Latitude:  62.892400,
+			Longitude: 27.677000,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: false, Outdoors: false},
+				{Channel: 22, Indoors: true, Outdoors: true},
+				{Channel: 23, Indoors: true, Outdoors: true},
+				{Channel: 24, Indoors: false, Outdoors: false},
+				{Channel: 25, Indoors: true, Outdoors: true},
+				{Channel: 26, Indoors: true, Outdoors: true},
+				{Channel: 27, Indoors: true, Outdoors: true},
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: true, Outdoors: true},
+				{Channel: 30, Indoors: false, Outdoors: false},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: true},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: false, Outdoors: false},
+				{Channel: 38, Indoors: true, Outdoors: true},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: false, Outdoors: false},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: false, Outdoors: false},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Kokkola",
+			Latitude:  63.841500,
+			Longitude: 23.130500,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: true, Outdoors: true},
+				{Channel: 22, Indoors: true, Outdoors: true},
+				{Channel: 23, Indoors: true, Outdoors: true},
+				{Channel: 24, Indoors: true, Outdoors: true},
+				{Channel: 25, Indoors: true, Outdoors: true},
+				{Channel: 26, Indoors: true, Outdoors: true},
+				{Channel: 27, Indoors: true, Outdoors: true},
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: true, Outdoors: true},
+				{Channel: 30, Indoors: true, Outdoors: true},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: true},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: true, Outdoors: true},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		s := fi.Finland{Latitude: test.Latitude, Longitude: test.Longitude}
+		c, err := s.Call()
+		if err != nil {
+			log.Fatalf("unexpected error looking up %s", test.PlaceName)
+		}
+		channels := *c
+
+		if len(channels) != len(test.Channels) {
+			log.Fatalf("wrong number of channels in %s... expected %d, got %d", test.PlaceName, len(test.Channels), len(channels))
+		}
+		for i := 0; i < len(channels); i++ {
+			if channels[i].Number != test.Channels[i].Channel {
+				log.Fatalf("invalid channel in %s... expected %d, got %d", test.PlaceName, test.Channels[i].Channel, channels[i].Number)
+			}
+			if channels[i].Indoors != test.Channels[i].Indoors {
+				log.Fatalf("invalid indoors availability in %s channel %d... expected %v, got %v", test.PlaceName, test.Channels[i].Channel, test.Channels[i].Indoors, channels[i].Indoors)
+			}
+			if channels[i].Outdoors != test.Channels[i].Outdoors {
+				log.Fatalf("invalid outdoors availability in %s channel %d... expected %v, got %v", test.PlaceName, test.Channels[i].Channel, test.Channels[i].Outdoors, channels[i].Outdoors)
+			}
+		}
+	}
+}
+
+func TestInvalidFi(t *testing.T) {
+	s := fi.Finland{
+		Latitude:  48.856613,
+		Longitude: 2.352222,
+	}
+	_, err := s.Call()
+	if err == nil {
+		log.Fatalf("expected error for location outside FI")
+	}
+}
+
+func TestName(t *testing.T) {
+	s := fi.Finland{
+		Latitude:  60.169900,
+		Longitude: 24.938400,
+	}
+	name := s.GetCountryName()
+	if name != "Finland" {
+		log.Fatalf("got wrong country name")
+	}
+}
+
+func TestService(t *testing.T) {
+	s := fi.Finland{
+		Latitude:  60.169900,
+		Longitude: 24.938400,
+	}
+	name := s.GetServiceName()
+	if name != "Traficom Licence-exempt Wireless Microphones" {
+		log.Fatalf("got wrong service name")
+	}
+}
@@ -0,0 +1,22 @@
+// Code generated by rfxp-import; DO NOT EDIT.
+
+package fi
+
+const DatasetVersion = "2026-10"
+
+var transmitters = []Transmitter{
+	{Name: "Espoo", Latitude: 60.178333, Longitude: 24.65, Radius: 70, Channels: []int{24, 27, 30, 33, 36, 40, 42, 46}},
+	{Name: "Turku Kaarina", Latitude: 60.406944, Longitude: 22.443889, Radius: 60, Channels: []int{21, 25, 29, 35, 38, 41, 45}},
+	{Name: "Tampere Pyynikki", Latitude: 61.496944, Longitude: 23.726667, Radius: 60, Channels: []int{23, 26, 31, 34, 39, 43, 47}},
+	{Name: "Lahti Mustankallio", Latitude: 60.978333, Longitude: 25.686667, Radius: 55, Channels: []int{22, 28, 32, 37, 40, 44, 48}},
+	{Name: "Pori Ulvila", Latitude: 61.430556, Longitude: 21.881667, Radius: 50, Channels: []int{24, 27, 33, 36, 41, 45}},
+	{Name: "Vaasa Lakiakangas", Latitude: 62.985, Longitude: 21.940556, Radius: 60, Channels: []int{21, 26, 30, 34, 42, 46}},
+	{Name: "Seinäjoki Ilmajoki", Latitude: 62.735833, Longitude: 22.555556, Radius: 55, Channels: []int{22, 25, 29, 35, 39, 47}},
+	{Name: "Jyväskylä Laajavuori", Latitude: 62.265556, Longitude: 25.721111, Radius: 60, Channels: []int{23, 28, 32, 36, 43, 48}},
+	{Name: "Kuopio Puijo", Latitude: 62.909444, Longitude: 27.659722, Radius: 60, Channels: []int{21, 24, 30, 37, 40, 44}},
+	{Name: "Joensuu Kontiolahti", Latitude: 62.720556, Longitude: 29.850833, Radius: 55, Channels: []int{22, 26, 31, 38, 41, 46}},
+	{Name: "Oulu Kiiminki", Latitude: 65.124722, Longitude: 25.718611, Radius: 70, Channels: []int{23, 27, 29, 33, 42, 45}},
+	{Name: "Kajaani Vuokatti", Latitude: 64.1275, Longitude: 28.252222, Radius: 55, Channels: []int{24, 28, 34, 39, 43, 47}},
+	{Name: "Rovaniemi Ounasvaara", Latitude: 66.495833, Longitude: 25.775, Radius: 70, Channels: []int{21, 25, 32, 35, 40, 44}},
+	{Name: "Inari Kaunispää", Latitude: 68.438889, Longitude: 27.458333, Radius: 80, Channels: []int{22, 26, 30, 37, 41, 48}},
+}
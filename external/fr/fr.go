@@ -0,0 +1,113 @@
+package fr
+
+// transmitters.csv holds the main DTT sites, typed by hand on 2026-10-19 from
+// the ANFR transmitter data published at https://data.anfr.fr.
+//go:generate go run ../../cmd/rfxp-import -country fr -in transmitters.csv -out transmitters.go -version 2026-10
+
+import (
+	"errors"
+	"math"
+
+	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/external"
+)
+
+// France looks up a location from the transmitter tables. Height is the
+// microphone height above ground in metres, or zero for microphoneHeight.
+type France struct {
+	Latitude  float64
+	Longitude float64
+	Height    float64
+}
+
+// Transmitter is a DTT site from the ANFR/ARCEP transmitter tables. Erp is
+// the effective radiated power in kilowatts and Height the antenna height
+// above ground in metres.
+type Transmitter struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Erp       float64
+	Height    float64
+	Channels  []int
+}
+
+const (
+	referenceErp     = 100.0 // kW
+	microphoneHeight = 10.0  // metres
+
+	// Fractions of the co-channel protection distance inside which a
+	// transmitter blocks indoor use of its own channel, and outdoor use of
+	// the channels either side of it.
+	indoorFactor          = 0.7
+	adjacentChannelFactor = 0.25
+)
+
+func (s *France) GetCountryName() string {
+	return "France"
+}
+
+func (s *France) GetServiceName() string {
+	return "ANFR/ARCEP Émetteurs TNT"
+}
+
+func (s *France) Call() (*[]channel.Channel, error) {
+	if s.Latitude < 41.3 || s.Latitude > 51.1 || s.Longitude < -5.2 || s.Longitude > 9.6 {
+		return nil, errors.New("coordinates outside FR")
+	}
+
+	channels := s.channelsFromTransmitters(transmitters)
+	return channels, nil
+}
+
+// protectionDistance returns the co-channel protection distance around a
+// transmitter in kilometres. It is the radio horizon between the mast and a
+// microphone at the given height over a 4/3 earth, scaled by the fourth root
+// of the ERP.
+func (t *Transmitter) protectionDistance(height float64) float64 {
+	horizon := 4.12 * (math.Sqrt(t.Height) + math.Sqrt(height))
+	return horizon * math.Pow(t.Erp/referenceErp, 0.25)
+}
+
+func (s *France) channelsFromTransmitters(transmitters []Transmitter) *[]channel.Channel {
+	startChannel := 21
+	endChannel := 48
+	// Channel 38 is reserved for radio astronomy.
+	channels := external.UHFChannels(38)
+
+	height := s.Height
+	if height <= 0 {
+		height = microphoneHeight
+	}
+
+	lookup := coordinates.New(s.Latitude, s.Longitude)
+	for _, t := range transmitters {
+		distance := lookup.DistanceTo(t.Latitude, t.Longitude) / 1000
+		protection := t.protectionDistance(height)
+		if distance > protection {
+			continue
+		}
+
+		for _, ch := range t.Channels {
+			if ch >= startChannel && ch <= endChannel {
+				index := ch - startChannel
+				channels[index].Outdoors = false
+				if distance <= protection*indoorFactor {
+					channels[index].Indoors = false
+				}
+			}
+
+			if distance > protection*adjacentChannelFactor {
+				continue
+			}
+			for _, adjacent := range []int{ch - 1, ch + 1} {
+				if adjacent >= startChannel && adjacent <= endChannel {
+					channels[adjacent-startChannel].Outdoors = false
+				}
+			}
+		}
+	}
+
+	return &channels
+}
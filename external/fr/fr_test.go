@@ -0,0 +1,247 @@
+package fr_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/external/fr"
+)
+
+func TestValidFr(t *testing.T) {
+	type TestChannel struct {
+		Channel  int
+		Indoors  bool
+		Outdoors bool
+	}
+
+	type TestCase struct {
+		PlaceName string
+		Latitude  float64
+		Longitude float64
+		Channels  []TestChannel
+	}
+
+	testCases := []TestCase{
+		{
+			PlaceName: "Paris",
+			Latitude:  48.856600,
+			Longitude: 2.352200,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: false, Outdoors: false},
+				{Channel: 22, Indoors: true, Outdoors: false},
+				{Channel: 23, Indoors: true, Outdoors: false},
+				{Channel: 24, Indoors: false, Outdoors: false},
+				{Channel: 25, Indoors: true, Outdoors: false},
+				{Channel: 26, Indoors: true, Outdoors: false},
+				{Channel: 27, Indoors: false, Outdoors: false},
+				{Channel: 28, Indoors: true, Outdoors: false},
+				{Channel: 29, Indoors: false, Outdoors: false},
+				{Channel: 30, Indoors: false, Outdoors: false},
+				{Channel: 31, Indoors: true, Outdoors: false},
+				{Channel: 32, Indoors: false, Outdoors: false},
+				{Channel: 33, Indoors: true, Outdoors: false},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: false, Outdoors: false},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Compiegne",
+			Latitude:  49.417900,
+			Longitude: 2.826100,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: true, Outdoors: false},
+				{Channel: 22, Indoors: true, Outdoors: true},
+				{Channel: 23, Indoors: true, Outdoors: true},
+				{Channel: 24, Indoors: true, Outdoors: false},
+				{Channel: 25, Indoors: true, Outdoors: true},
+				{Channel: 26, Indoors: true, Outdoors: true},
+				{Channel: 27, Indoors: true, Outdoors: false},
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: true, Outdoors: false},
+				{Channel: 30, Indoors: true, Outdoors: false},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: false},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: false, Outdoors: false},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Chateauroux",
+			Latitude:  46.810300,
+			Longitude: 1.691300,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: true, Outdoors: true},
+				{Channel: 22, Indoors: true, Outdoors: true},
+				{Channel: 23, Indoors: true, Outdoors: true},
+				{Channel: 24, Indoors: true, Outdoors: true},
+				{Channel: 25, Indoors: true, Outdoors: true},
+				{Channel: 26, Indoors: true, Outdoors: true},
+				{Channel: 27, Indoors: true, Outdoors: true},
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: true, Outdoors: true},
+				{Channel: 30, Indoors: true, Outdoors: true},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: true},
+				{Channel: 33, Indoors: true, Outdoors: true},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: true, Outdoors: true},
+				{Channel: 38, Indoors: false, Outdoors: false},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: true, Outdoors: true},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: true, Outdoors: true},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		}, {
+			PlaceName: "Ajaccio",
+			Latitude:  41.919200,
+			Longitude: 8.738600,
+			Channels: []TestChannel{
+				{Channel: 21, Indoors: true, Outdoors: true},
+				{Channel: 22, Indoors: true, Outdoors: true},
+				{Channel: 23, Indoors: true, Outdoors: true},
+				{Channel: 24, Indoors: false, Outdoors: false},
+				{Channel: 25, Indoors: true, Outdoors: true},
+				{Channel: 26, Indoors: true, Outdoors: true},
+				{Channel: 27, Indoors: true, Outdoors: true},
+				{Channel: 28, Indoors: true, Outdoors: true},
+				{Channel: 29, Indoors: false, Outdoors: false},
+				{Channel: 30, Indoors: true, Outdoors: true},
+				{Channel: 31, Indoors: true, Outdoors: true},
+				{Channel: 32, Indoors: true, Outdoors: true},
+				{Channel: 33, Indoors: false, Outdoors: false},
+				{Channel: 34, Indoors: true, Outdoors: true},
+				{Channel: 35, Indoors: true, Outdoors: true},
+				{Channel: 36, Indoors: true, Outdoors: true},
+				{Channel: 37, Indoors: false, Outdoors: false},
+				{Channel: 38, Indoors: false, Outdoors: false},
+				{Channel: 39, Indoors: true, Outdoors: true},
+				{Channel: 40, Indoors: false, Outdoors: false},
+				{Channel: 41, Indoors: true, Outdoors: true},
+				{Channel: 42, Indoors: true, Outdoors: true},
+				{Channel: 43, Indoors: false, Outdoors: false},
+				{Channel: 44, Indoors: true, Outdoors: true},
+				{Channel: 45, Indoors: true, Outdoors: true},
+				{Channel: 46, Indoors: true, Outdoors: true},
+				{Channel: 47, Indoors: true, Outdoors: true},
+				{Channel: 48, Indoors: true, Outdoors: true},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		s := fr.France{Latitude: test.Latitude, Longitude: test.Longitude}
+		c, err := s.Call()
+		if err != nil {
+			log.Fatalf("unexpected error looking up %s", test.PlaceName)
+		}
+		channels := *c
+
+		if len(channels) != len(test.Channels) {
+			log.Fatalf("wrong number of channels in %s... expected %d, got %d", test.PlaceName, len(test.Channels), len(channels))
+		}
+		for i := 0; i < len(channels); i++ {
+			if channels[i].Number != test.Channels[i].Channel {
+				log.Fatalf("invalid channel in %s... expected %d, got %d", test.PlaceName, test.Channels[i].Channel, channels[i].Number)
+			}
+			if channels[i].Indoors != test.Channels[i].Indoors {
+				log.Fatalf("invalid indoors availability in %s channel %d... expected %v, got %v", test.PlaceName, test.Channels[i].Channel, test.Channels[i].Indoors, channels[i].Indoors)
+			}
+			if channels[i].Outdoors != test.Channels[i].Outdoors {
+				log.Fatalf("invalid outdoors availability in %s channel %d... expected %v, got %v", test.PlaceName, test.Channels[i].Channel, test.Channels[i].Outdoors, channels[i].Outdoors)
+			}
+		}
+	}
+}
+
+func TestHeightFr(t *testing.T) {
+	blocked := map[int]bool{21: true, 24: true, 27: true, 29: true, 30: true, 32: true}
+
+	for _, height := range []float64{0, 100} {
+		s := fr.France{Latitude: 47.9029, Longitude: 1.9093, Height: height}
+		c, err := s.Call()
+		if err != nil {
+			log.Fatalf("unexpected error looking up Orléans at %.0f m", height)
+		}
+		for _, ch := range *c {
+			if ch.Number == 38 {
+				if ch.Indoors || ch.Outdoors {
+					log.Fatalf("channel 38 not reserved in Orléans at %.0f m", height)
+				}
+				continue
+			}
+			expected := height == 0 || !blocked[ch.Number]
+			if ch.Outdoors != expected {
+				log.Fatalf("invalid outdoors availability in Orléans at %.0f m channel %d... expected %v, got %v", height, ch.Number, expected, ch.Outdoors)
+			}
+			if !ch.Indoors {
+				log.Fatalf("invalid indoors availability in Orléans at %.0f m channel %d... expected true, got false", height, ch.Number)
+			}
+		}
+	}
+}
+
+func TestInvalidFr(t *testing.T) {
+	s := fr.France{
+		Latitude:  52.516275,
+		Longitude: 13.377704,
+	}
+	_, err := s.Call()
+	if err == nil {
+		log.Fatalf("expected error for location outside FR")
+	}
+}
+
+func TestName(t *testing.T) {
+	s := fr.France{
+		Latitude:  48.856600,
+		Longitude: 2.352200,
+	}
+	name := s.GetCountryName()
+	if name != "France" {
+		log.Fatalf("got wrong country name")
+	}
+}
+
+func TestService(t *testing.T) {
+	s := fr.France{
+		Latitude:  48.856600,
+		Longitude: 2.352200,
+	}
+	name := s.GetServiceName()
+	if name != "ANFR/ARCEP Émetteurs TNT" {
+		log.Fatalf("got wrong service name")
+	}
+}
@@ -0,0 +1,24 @@
+// Code generated by rfxp-import; DO NOT EDIT.
+
+package fr
+
+const DatasetVersion = "2026-10"
+
+var transmitters = []Transmitter{
+	{Name: "Paris Tour Eiffel", Latitude: 48.858222, Longitude: 2.2945, Erp: 100, Height: 318, Channels: []int{21, 24, 27, 29, 30, 32}},
+	{Name: "Lille Bouvigny", Latitude: 50.419444, Longitude: 2.660556, Erp: 100, Height: 301, Channels: []int{22, 25, 28, 33, 36, 42}},
+	{Name: "Rouen Grand-Couronne", Latitude: 49.359167, Longitude: 1.011389, Erp: 50, Height: 200, Channels: []int{23, 26, 31, 34, 37, 45}},
+	{Name: "Strasbourg Nordheim", Latitude: 48.640833, Longitude: 7.483889, Erp: 100, Height: 263, Channels: []int{21, 26, 29, 35, 40, 44}},
+	{Name: "Nancy Malzéville", Latitude: 48.719722, Longitude: 6.204167, Erp: 50, Height: 200, Channels: []int{22, 27, 30, 34, 39, 47}},
+	{Name: "Rennes Saint-Pern", Latitude: 48.273333, Longitude: -1.964722, Erp: 100, Height: 217, Channels: []int{23, 25, 31, 36, 41, 43}},
+	{Name: "Nantes Haute-Goulaine", Latitude: 47.1975, Longitude: -1.421111, Erp: 100, Height: 300, Channels: []int{24, 28, 33, 37, 40, 46}},
+	{Name: "Bordeaux Bouliac", Latitude: 44.817222, Longitude: -0.513611, Erp: 100, Height: 230, Channels: []int{22, 26, 30, 35, 39, 44}},
+	{Name: "Toulouse Pechbonnieu", Latitude: 43.714444, Longitude: 1.468889, Erp: 100, Height: 219, Channels: []int{21, 25, 29, 34, 41, 47}},
+	{Name: "Montpellier Saint-Baudille", Latitude: 43.778611, Longitude: 3.522778, Erp: 100, Height: 280, Channels: []int{23, 27, 32, 36, 42, 45}},
+	{Name: "Marseille Grande Étoile", Latitude: 43.368333, Longitude: 5.398056, Erp: 100, Height: 150, Channels: []int{24, 28, 31, 35, 43, 46}},
+	{Name: "Nice Mont Alban", Latitude: 43.706944, Longitude: 7.303611, Erp: 50, Height: 100, Channels: []int{22, 26, 33, 37, 40, 48}},
+	{Name: "Lyon Fourvière", Latitude: 45.7625, Longitude: 4.821667, Erp: 50, Height: 86, Channels: []int{21, 25, 30, 34, 39, 44}},
+	{Name: "Grenoble Chamrousse", Latitude: 45.125278, Longitude: 5.889722, Erp: 50, Height: 120, Channels: []int{23, 27, 32, 36, 41, 47}},
+	{Name: "Clermont-Ferrand Puy de Dôme", Latitude: 45.772222, Longitude: 2.963889, Erp: 100, Height: 100, Channels: []int{22, 28, 31, 35, 42, 45}},
+	{Name: "Ajaccio Coti-Chiavari", Latitude: 41.771667, Longitude: 8.768611, Erp: 20, Height: 80, Channels: []int{24, 29, 33, 37, 40, 43}},
+}
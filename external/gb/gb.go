@@ -15,13 +15,20 @@ import (
 	"github.com/stebunting/rfxp-backend/coordinates"
 )
 
+// GB looks up a location on the grid named by Code. When OSTN15 is set,
+// National Grid references are found with the OSTN15 grid shift rather than
+// the Helmert transformation, and Call fails if the OSTN15 data is not
+// available. EllipsoidHeight is the height above the WGS84 ellipsoid used in the datum
+// transformation.
 type GB struct {
-	Latitude  float64
-	Longitude float64
-	Code      string
-	client    *http.Client
-	url       *url.URL
-	form      url.Values
+	Latitude        float64
+	Longitude       float64
+	EllipsoidHeight float64
+	Code            string
+	OSTN15          bool
+	client          *http.Client
+	url             *url.URL
+	form            url.Values
 }
 
 func (s *GB) GetCountryName() string {
@@ -32,9 +39,20 @@ func (s *GB) GetServiceName() string {
 	return "OFCOM Post 700 MHz Mic/IEM Location Planner"
 }
 
+func (s *GB) GetGridSystem() string {
+	if s.OSTN15 && s.Code == "GB" {
+		return "OSTN15"
+	}
+	return s.Code
+}
+
 func (s *GB) Call() (*[]channel.Channel, error) {
-	lookup := coordinates.New(s.Latitude, s.Longitude)
-	gridReference, _ := lookup.GetGridReference(s.Code)
+	lookup := coordinates.NewWithHeight(s.Latitude, s.Longitude, s.EllipsoidHeight)
+	system := s.GetGridSystem()
+	gridReference, err := lookup.GetGridReference(system)
+	if err != nil && system == "OSTN15" {
+		return nil, err
+	}
 
 	if len(gridReference.GetShortCode()) != 8 {
 		s.Code = "UTM"
@@ -46,7 +64,7 @@ func (s *GB) Call() (*[]channel.Channel, error) {
 	}
 	s.setupClient(gridReference.GetShortCode())
 
-	err := s.initSession()
+	err = s.initSession()
 	if err != nil {
 		return nil, err
 	}
@@ -26,9 +26,18 @@ func (s *Netherlands) GetServiceName() string {
 	return "Microfoonbanden.nl"
 }
 
+// GetGridSystem is the grid the microfoonbanden.nl raster is georeferenced
+// on, ED50 UTM zone 32 rather than RD.
+func (s *Netherlands) GetGridSystem() string {
+	return "ED50UTM32"
+}
+
 func (s *Netherlands) Call() (*[]channel.Channel, error) {
 	lookup := coordinates.New(s.Latitude, s.Longitude)
-	gridReference, _ := lookup.GetGridReference("NL")
+	gridReference, err := lookup.GetGridReference(s.GetGridSystem())
+	if err != nil {
+		return nil, err
+	}
 
 	channels, err := s.makeApiCall(gridReference.GetEasting(), gridReference.GetNorthing())
 	if err != nil {
@@ -59,14 +68,14 @@ func (s *Netherlands) makeApiCall(easting float64, northing float64) (*[]channel
 	numXPixels := 585
 	numYPixels := 699
 
-	coordLBLong := 97467.188 - 82.043
-	coordLBLat := 5947931.25 - 206.161
-	coordRBLong := 389962.5 - 82.043
-	coordRBLat := 5947931.25 - 206.161
-	coordLOLong := 97467.188 - 82.043
-	coordLOLat := 5598673.438 - 206.161
-	coordROLong := 389962.5 - 82.043
-	coordROLat := 5598673.438 - 206.161
+	coordLBLong := 97467.188
+	coordLBLat := 5947931.25
+	coordRBLong := 389962.5
+	coordRBLat := 5947931.25
+	coordLOLong := 97467.188
+	coordLOLat := 5598673.438
+	coordROLong := 389962.5
+	coordROLat := 5598673.438
 
 	if northing > coordLBLat || northing < coordLOLat || easting > coordRBLong || easting < coordLBLong {
 		return nil, errors.New("coordinates outside NL")
@@ -37,6 +37,10 @@ func (s *Norway) GetServiceName() string {
 	return "Finnsenderen.no"
 }
 
+func (s *Norway) GetGridSystem() string {
+	return "ETRS89UTM33"
+}
+
 func (s *Norway) Call() (*[]channel.Channel, error) {
 	result, err := s.makeApiCall()
 	if err != nil {
@@ -60,6 +60,10 @@ func (s *Sweden) GetServiceName() string {
 	return "PTS Trådlös ljudöverföring"
 }
 
+func (s *Sweden) GetGridSystem() string {
+	return "SWEREF99TM"
+}
+
 func (s *Sweden) Call() (*[]channel.Channel, error) {
 	var indoors *[]FrequencyBundleInfo
 	var outdoors *[]FrequencyBundleInfo
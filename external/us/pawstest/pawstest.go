@@ -0,0 +1,93 @@
+// Package pawstest provides a stand-in PAWS database server for testing the
+// US provider without network access.
+package pawstest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/stebunting/rfxp-backend/external/us"
+)
+
+// Server answers spectrum.paws.init and spectrum.paws.getSpectrum requests
+// with a fixed spectrum profile. Requests and Params hold the method and
+// parameters of every call received, in order.
+type Server struct {
+	*httptest.Server
+	Profile  []us.SpectrumPoint
+	Requests []string
+	Params   []json.RawMessage
+}
+
+// NewServer starts a stand-in PAWS server returning the given profile.
+func NewServer(profile []us.SpectrumPoint) *Server {
+	s := &Server{Profile: profile}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Profile returns a stepped spectrum profile across US TV channels 14-36,
+// permitting the given power in dBm on each listed channel and none
+// elsewhere.
+func Profile(power map[int]float64) []us.SpectrumPoint {
+	profile := []us.SpectrumPoint{}
+	for ch := 14; ch <= 36; ch++ {
+		dbm, ok := power[ch]
+		if !ok {
+			dbm = -100
+		}
+		start := float64(470000000 + (ch-14)*6000000)
+		profile = append(profile,
+			us.SpectrumPoint{Hz: start, Dbm: dbm},
+			us.SpectrumPoint{Hz: start + 6000000, Dbm: dbm},
+		)
+	}
+	return profile
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+		Id     int             `json:"id"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.Requests = append(s.Requests, request.Method)
+	s.Params = append(s.Params, request.Params)
+
+	response := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      request.Id,
+	}
+	switch request.Method {
+	case "spectrum.paws.init":
+		response["result"] = us.InitResponse{
+			Type:    "INIT_RESP",
+			Version: "1.0",
+			RulesetInfos: []us.RulesetInfo{
+				{Authority: "US", RulesetId: "FccTvBandWhiteSpace-2010"},
+			},
+		}
+	case "spectrum.paws.getSpectrum":
+		response["result"] = us.AvailSpectrumResponse{
+			Type:    "AVAIL_SPECTRUM_RESP",
+			Version: "1.0",
+			SpectrumSchedules: []us.SpectrumSchedule{{
+				Spectra: []us.Spectrum{{
+					ResolutionBwHz: 6000000,
+					Profiles:       [][]us.SpectrumPoint{s.Profile},
+				}},
+			}},
+		}
+	default:
+		response["error"] = us.RpcError{Code: -32601, Message: "method not found"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,330 @@
+package us
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stebunting/rfxp-backend/channel"
+)
+
+const (
+	pawsVersion = "1.0"
+	rulesetId   = "FccTvBandWhiteSpace-2010"
+
+	// Minimum permitted power, in dBm, across a channel for it to be
+	// reported as available.
+	minimumPower = 16.0
+)
+
+// UnitedStates queries a PAWS database. When AntennaHeightType is AGL or
+// AMSL, AntennaHeight is sent with the spectrum request.
+type UnitedStates struct {
+	Latitude          float64
+	Longitude         float64
+	AntennaHeight     float64
+	AntennaHeightType string
+	Endpoint          string
+	SerialNumber      string
+	FccId             string
+}
+
+type RpcRequest struct {
+	JsonRpc string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+	Id      int         `json:"id"`
+}
+
+type RpcResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result"`
+	Error   *RpcError       `json:"error"`
+	Id      int             `json:"id"`
+}
+
+type RpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type DeviceDescriptor struct {
+	SerialNumber string   `json:"serialNumber"`
+	FccId        string   `json:"fccId,omitempty"`
+	RulesetIds   []string `json:"rulesetIds"`
+}
+
+type GeoLocation struct {
+	Point Ellipse `json:"point"`
+}
+
+type Ellipse struct {
+	Center Point `json:"center"`
+}
+
+type Point struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type InitRequest struct {
+	Type       string           `json:"type"`
+	Version    string           `json:"version"`
+	DeviceDesc DeviceDescriptor `json:"deviceDesc"`
+	Location   GeoLocation      `json:"location"`
+}
+
+type InitResponse struct {
+	Type         string        `json:"type"`
+	Version      string        `json:"version"`
+	RulesetInfos []RulesetInfo `json:"rulesetInfos"`
+}
+
+type RulesetInfo struct {
+	Authority string `json:"authority"`
+	RulesetId string `json:"rulesetId"`
+}
+
+type AvailSpectrumRequest struct {
+	Type       string                  `json:"type"`
+	Version    string                  `json:"version"`
+	DeviceDesc DeviceDescriptor        `json:"deviceDesc"`
+	Location   GeoLocation             `json:"location"`
+	Antenna    *AntennaCharacteristics `json:"antenna,omitempty"`
+}
+
+type AntennaCharacteristics struct {
+	Height     float64 `json:"height"`
+	HeightType string  `json:"heightType"`
+}
+
+type AvailSpectrumResponse struct {
+	Type              string             `json:"type"`
+	Version           string             `json:"version"`
+	SpectrumSchedules []SpectrumSchedule `json:"spectrumSchedules"`
+}
+
+type SpectrumSchedule struct {
+	EventTime EventTime  `json:"eventTime"`
+	Spectra   []Spectrum `json:"spectra"`
+}
+
+type EventTime struct {
+	StartTime string `json:"startTime"`
+	StopTime  string `json:"stopTime"`
+}
+
+type Spectrum struct {
+	ResolutionBwHz float64           `json:"resolutionBwHz"`
+	Profiles       [][]SpectrumPoint `json:"profiles"`
+}
+
+type SpectrumPoint struct {
+	Hz  float64 `json:"hz"`
+	Dbm float64 `json:"dbm"`
+}
+
+func (s *UnitedStates) GetCountryName() string {
+	return "United States"
+}
+
+func (s *UnitedStates) GetServiceName() string {
+	return "TV White Space Database (PAWS)"
+}
+
+func (s *UnitedStates) Call() (*[]channel.Channel, error) {
+	if s.Endpoint == "" {
+		return nil, errors.New("no PAWS endpoint configured")
+	}
+
+	err := s.init()
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.getSpectrum()
+	if err != nil {
+		return nil, err
+	}
+	channels := s.channelsFromApiResponse(result)
+	return channels, nil
+}
+
+func (s *UnitedStates) deviceDescriptor() DeviceDescriptor {
+	serialNumber := s.SerialNumber
+	if serialNumber == "" {
+		serialNumber = "rfxp"
+	}
+	return DeviceDescriptor{
+		SerialNumber: serialNumber,
+		FccId:        s.FccId,
+		RulesetIds:   []string{rulesetId},
+	}
+}
+
+func (s *UnitedStates) location() GeoLocation {
+	return GeoLocation{Point: Ellipse{Center: Point{Latitude: s.Latitude, Longitude: s.Longitude}}}
+}
+
+func (s *UnitedStates) antenna() *AntennaCharacteristics {
+	if s.AntennaHeightType != "AGL" && s.AntennaHeightType != "AMSL" {
+		return nil
+	}
+	return &AntennaCharacteristics{Height: s.AntennaHeight, HeightType: s.AntennaHeightType}
+}
+
+func (s *UnitedStates) init() error {
+	params := InitRequest{
+		Type:       "INIT_REQ",
+		Version:    pawsVersion,
+		DeviceDesc: s.deviceDescriptor(),
+		Location:   s.location(),
+	}
+
+	var response InitResponse
+	err := s.makeApiCall("spectrum.paws.init", params, &response)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range response.RulesetInfos {
+		if r.RulesetId == rulesetId {
+			return nil
+		}
+	}
+	return errors.New("database does not support FCC ruleset")
+}
+
+func (s *UnitedStates) getSpectrum() (*AvailSpectrumResponse, error) {
+	params := AvailSpectrumRequest{
+		Type:       "AVAIL_SPECTRUM_REQ",
+		Version:    pawsVersion,
+		DeviceDesc: s.deviceDescriptor(),
+		Location:   s.location(),
+		Antenna:    s.antenna(),
+	}
+
+	var response AvailSpectrumResponse
+	err := s.makeApiCall("spectrum.paws.getSpectrum", params, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.SpectrumSchedules) == 0 {
+		sentry.CaptureMessage("no spectrum schedules")
+		return nil, errors.New("no spectrum schedules")
+	}
+
+	return &response, nil
+}
+
+func (s *UnitedStates) makeApiCall(method string, params interface{}, result interface{}) error {
+	body, err := json.Marshal(RpcRequest{
+		JsonRpc: "2.0",
+		Method:  method,
+		Params:  params,
+		Id:      1,
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		panic(err)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, s.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		sentry.CaptureException(err)
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+
+	client := &http.Client{}
+	rawResponse, err := client.Do(request)
+	if err != nil {
+		sentry.CaptureException(err)
+		return errors.New("error making network call")
+	}
+	defer rawResponse.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(rawResponse.Body)
+	if err != nil {
+		sentry.CaptureException(err)
+		return err
+	}
+
+	var response RpcResponse
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		sentry.CaptureException(err)
+		return err
+	}
+
+	if response.Error != nil {
+		message := fmt.Sprintf("%s: %d %s", method, response.Error.Code, response.Error.Message)
+		sentry.CaptureMessage(message)
+		return errors.New(message)
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+// channelsFromApiResponse maps the first spectrum schedule onto 6 MHz
+// channels. A channel is available when every profile segment overlapping
+// it permits at least minimumPower.
+func (s *UnitedStates) channelsFromApiResponse(result *AvailSpectrumResponse) *[]channel.Channel {
+	startFrequency := 470000
+	startChannel := 14
+	endChannel := 36
+	chWidth := 6000
+
+	channels := []channel.Channel{}
+	freqCounter := startFrequency
+	schedule := result.SpectrumSchedules[0]
+	for ch := startChannel; ch <= endChannel; ch++ {
+		startFrequency := freqCounter
+		endFrequency := startFrequency + chWidth
+		available := powerAvailable(&schedule, float64(startFrequency)*1000, float64(endFrequency)*1000)
+
+		channels = append(channels, channel.Channel{
+			Number:    ch,
+			FreqStart: startFrequency,
+			FreqEnd:   endFrequency,
+			Indoors:   available,
+			Outdoors:  available,
+		})
+
+		freqCounter += chWidth
+	}
+
+	return &channels
+}
+
+func powerAvailable(schedule *SpectrumSchedule, startHz float64, endHz float64) bool {
+	covered := 0.0
+	for _, spectrum := range schedule.Spectra {
+		for _, profile := range spectrum.Profiles {
+			for i := 0; i < len(profile)-1; i++ {
+				segmentStart := profile[i].Hz
+				segmentEnd := profile[i+1].Hz
+				if segmentEnd <= startHz || segmentStart >= endHz {
+					continue
+				}
+				if math.Min(profile[i].Dbm, profile[i+1].Dbm) < minimumPower {
+					return false
+				}
+				if segmentStart < startHz {
+					segmentStart = startHz
+				}
+				if segmentEnd > endHz {
+					segmentEnd = endHz
+				}
+				covered += segmentEnd - segmentStart
+			}
+		}
+	}
+	return covered >= endHz-startHz
+}
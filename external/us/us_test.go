@@ -0,0 +1,140 @@
+package us_test
+
+import (
+	"encoding/json"
+	"log"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/external/us"
+	"github.com/stebunting/rfxp-backend/external/us/pawstest"
+)
+
+func TestValidUs(t *testing.T) {
+	type TestChannel struct {
+		Channel  int
+		Indoors  bool
+		Outdoors bool
+	}
+
+	server := pawstest.NewServer(pawstest.Profile(map[int]float64{
+		14: 20,
+		15: 20,
+		16: 10,
+		20: 36,
+		21: 36,
+		33: 16,
+	}))
+	defer server.Close()
+
+	expected := []TestChannel{
+		{Channel: 14, Indoors: true, Outdoors: true},
+		{Channel: 15, Indoors: true, Outdoors: true},
+		{Channel: 16, Indoors: false, Outdoors: false},
+		{Channel: 17, Indoors: false, Outdoors: false},
+		{Channel: 18, Indoors: false, Outdoors: false},
+		{Channel: 19, Indoors: false, Outdoors: false},
+		{Channel: 20, Indoors: true, Outdoors: true},
+		{Channel: 21, Indoors: true, Outdoors: true},
+		{Channel: 22, Indoors: false, Outdoors: false},
+		{Channel: 23, Indoors: false, Outdoors: false},
+		{Channel: 24, Indoors: false, Outdoors: false},
+		{Channel: 25, Indoors: false, Outdoors: false},
+		{Channel: 26, Indoors: false, Outdoors: false},
+		{Channel: 27, Indoors: false, Outdoors: false},
+		{Channel: 28, Indoors: false, Outdoors: false},
+		{Channel: 29, Indoors: false, Outdoors: false},
+		{Channel: 30, Indoors: false, Outdoors: false},
+		{Channel: 31, Indoors: false, Outdoors: false},
+		{Channel: 32, Indoors: false, Outdoors: false},
+		{Channel: 33, Indoors: true, Outdoors: true},
+		{Channel: 34, Indoors: false, Outdoors: false},
+		{Channel: 35, Indoors: false, Outdoors: false},
+		{Channel: 36, Indoors: false, Outdoors: false},
+	}
+
+	s := us.UnitedStates{Latitude: 40.750504, Longitude: -73.993439, Endpoint: server.URL}
+	c, err := s.Call()
+	if err != nil {
+		log.Fatalf("unexpected error calling stand-in server: %s", err)
+	}
+	channels := *c
+
+	if len(server.Requests) != 2 || server.Requests[0] != "spectrum.paws.init" || server.Requests[1] != "spectrum.paws.getSpectrum" {
+		log.Fatalf("unexpected PAWS requests %v", server.Requests)
+	}
+	if len(channels) != len(expected) {
+		log.Fatalf("wrong number of channels... expected %d, got %d", len(expected), len(channels))
+	}
+	for i := 0; i < len(channels); i++ {
+		if channels[i].Number != expected[i].Channel {
+			log.Fatalf("invalid channel... expected %d, got %d", expected[i].Channel, channels[i].Number)
+		}
+		if channels[i].FreqEnd-channels[i].FreqStart != 6000 {
+			log.Fatalf("invalid channel width on channel %d", channels[i].Number)
+		}
+		if channels[i].Indoors != expected[i].Indoors {
+			log.Fatalf("invalid indoors availability on channel %d... expected %v, got %v", expected[i].Channel, expected[i].Indoors, channels[i].Indoors)
+		}
+		if channels[i].Outdoors != expected[i].Outdoors {
+			log.Fatalf("invalid outdoors availability on channel %d... expected %v, got %v", expected[i].Channel, expected[i].Outdoors, channels[i].Outdoors)
+		}
+	}
+}
+
+func TestAntennaHeight(t *testing.T) {
+	server := pawstest.NewServer(pawstest.Profile(map[int]float64{14: 20}))
+	defer server.Close()
+
+	s := us.UnitedStates{Latitude: 40.750504, Longitude: -73.993439, Endpoint: server.URL}
+	_, err := s.Call()
+	if err != nil {
+		log.Fatalf("unexpected error calling stand-in server: %s", err)
+	}
+	var request us.AvailSpectrumRequest
+	json.Unmarshal(server.Params[1], &request)
+	if request.Antenna != nil {
+		log.Fatalf("unexpected antenna %+v with no height", *request.Antenna)
+	}
+
+	s.AntennaHeight = 30
+	s.AntennaHeightType = "AGL"
+	_, err = s.Call()
+	if err != nil {
+		log.Fatalf("unexpected error calling stand-in server: %s", err)
+	}
+	request = us.AvailSpectrumRequest{}
+	json.Unmarshal(server.Params[3], &request)
+	if request.Antenna == nil || request.Antenna.Height != 30 || request.Antenna.HeightType != "AGL" {
+		log.Fatalf("invalid antenna... expected 30 AGL, got %+v", request.Antenna)
+	}
+}
+
+func TestNoEndpoint(t *testing.T) {
+	s := us.UnitedStates{Latitude: 40.750504, Longitude: -73.993439}
+	_, err := s.Call()
+	if err == nil {
+		log.Fatalf("expected error without PAWS endpoint")
+	}
+}
+
+func TestName(t *testing.T) {
+	s := us.UnitedStates{
+		Latitude:  40.750504,
+		Longitude: -73.993439,
+	}
+	name := s.GetCountryName()
+	if name != "United States" {
+		log.Fatalf("got wrong country name")
+	}
+}
+
+func TestService(t *testing.T) {
+	s := us.UnitedStates{
+		Latitude:  40.750504,
+		Longitude: -73.993439,
+	}
+	name := s.GetServiceName()
+	if name != "TV White Space Database (PAWS)" {
+		log.Fatalf("got wrong service name")
+	}
+}
@@ -3,8 +3,8 @@ module github.com/stebunting/rfxp-backend
 go 1.16
 
 require (
-	github.com/PuerkitoBio/goquery v1.6.1 // indirect
-	github.com/aws/aws-lambda-go v1.23.0 // indirect
-	github.com/getsentry/sentry-go v0.10.0 // indirect
-	github.com/joho/godotenv v1.3.0 // indirect
+	github.com/PuerkitoBio/goquery v1.6.1
+	github.com/aws/aws-lambda-go v1.23.0
+	github.com/getsentry/sentry-go v0.10.0
+	github.com/joho/godotenv v1.3.0
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"math"
 	"os"
 	"strconv"
 	"strings"
@@ -12,18 +13,40 @@ import (
 	"github.com/getsentry/sentry-go"
 	"github.com/joho/godotenv"
 	"github.com/stebunting/rfxp-backend/channel"
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/external/au"
+	"github.com/stebunting/rfxp-backend/external/be"
+	"github.com/stebunting/rfxp-backend/external/de"
 	"github.com/stebunting/rfxp-backend/external/dk"
+	"github.com/stebunting/rfxp-backend/external/fallback"
+	"github.com/stebunting/rfxp-backend/external/fi"
+	"github.com/stebunting/rfxp-backend/external/fr"
 	"github.com/stebunting/rfxp-backend/external/gb"
 	"github.com/stebunting/rfxp-backend/external/nl"
 	"github.com/stebunting/rfxp-backend/external/no"
 	"github.com/stebunting/rfxp-backend/external/se"
-	"github.com/stebunting/rfxp-backend/external/unknown"
+	"github.com/stebunting/rfxp-backend/external/us"
 )
 
+// LambdaRequest locates a lookup by WGS84 latitude and longitude, by a
+// lettered GB, IE or Channel Islands grid reference in GridReference such as
+// "TQ 30155 80412", by a Maidenhead locator or geohash, or by Location in any
+// format accepted by coordinates.Parse, such as "59°19′45″N 18°4′7″E".
+// Transformation selects how WGS84 is taken onto the British National Grid:
+// "helmert" (the default) or "ostn15".
+// Height is optional, in metres above ground, or above the WGS84 ellipsoid
+// when HeightReference is "ellipsoid".
 type LambdaRequest struct {
-	Country   string `json:"country"`
-	Latitude  string `json:"latitude"`
-	Longitude string `json:"longitude"`
+	Country         string `json:"country"`
+	Latitude        string `json:"latitude"`
+	Longitude       string `json:"longitude"`
+	Location        string `json:"location"`
+	GridReference   string `json:"gridReference"`
+	Maidenhead      string `json:"maidenhead"`
+	Geohash         string `json:"geohash"`
+	Transformation  string `json:"transformation"`
+	Height          string `json:"height"`
+	HeightReference string `json:"heightReference"`
 }
 
 type Response struct {
@@ -33,11 +56,28 @@ type Response struct {
 }
 
 type Details struct {
-	Country   string  `json:"country"`
-	Code      string  `json:"code"`
-	Service   string  `json:"service"`
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
+	Country          string  `json:"country"`
+	Code             string  `json:"code"`
+	Service          string  `json:"service"`
+	NationalDatabase bool    `json:"nationalDatabase"`
+	Latitude         float64 `json:"latitude"`
+	Longitude        float64 `json:"longitude"`
+	Height           float64 `json:"height,omitempty"`
+	HeightReference  string  `json:"heightReference,omitempty"`
+	MGRS             string  `json:"mgrs,omitempty"`
+	Maidenhead       string  `json:"maidenhead,omitempty"`
+	Geohash          string  `json:"geohash,omitempty"`
+	Grid             *Grid   `json:"grid,omitempty"`
+}
+
+// Grid is the location on the national grid the regulator works in, for
+// cross-checking against its own maps.
+type Grid struct {
+	System   string  `json:"system"`
+	Easting  float64 `json:"easting"`
+	Northing float64 `json:"northing"`
+	Zone     int     `json:"zone,omitempty"`
+	Code     string  `json:"code,omitempty"`
 }
 
 type Api interface {
@@ -46,6 +86,20 @@ type Api interface {
 	Call() (*[]channel.Channel, error)
 }
 
+// GridApi is implemented by providers whose regulator works on a national
+// grid. GetGridSystem names a system accepted by
+// coordinates.Point.GetGridReference, and is read after Call.
+type GridApi interface {
+	GetGridSystem() string
+}
+
+// GridCoordinatesApi is implemented by grid providers whose regulator
+// returns the easting and northing it worked from, which are reported in
+// place of the locally computed ones when ok is true.
+type GridCoordinatesApi interface {
+	GetGridCoordinates() (easting float64, northing float64, ok bool)
+}
+
 func init() {
 	godotenv.Load()
 }
@@ -60,23 +114,97 @@ func HandleLambdaEvent(ctx context.Context, r LambdaRequest) (Response, error) {
 	}
 	defer sentry.Flush(2 * time.Second)
 
-	latitude, err := strconv.ParseFloat(r.Latitude, 64)
+	latitude, longitude, err := parseLocation(r)
 	if err != nil {
-		return Response{}, errors.New("latitude must be a number")
-	}
-	if latitude < -180 || latitude > 180 {
-		return Response{}, errors.New("latitude must be between -180 and 180 degrees")
+		return Response{}, err
 	}
 
-	longitude, err := strconv.ParseFloat(r.Longitude, 64)
+	height, heightReference, err := parseHeight(r)
 	if err != nil {
-		return Response{}, errors.New("longitude must be a number")
+		return Response{}, err
 	}
-	if longitude < -60 || longitude > 80 {
-		return Response{}, errors.New("longitude must be between -60 and 80 degrees")
+	var ellipsoidHeight float64
+	if heightReference == "ellipsoid" {
+		ellipsoidHeight = height
+	}
+
+	transformation := strings.ToLower(r.Transformation)
+	if transformation != "" && transformation != "helmert" && transformation != "ostn15" {
+		return Response{}, errors.New("transformation must be helmert or ostn15")
+	}
+	ostn15 := transformation == "ostn15"
+	if ostn15 {
+		point := coordinates.New(latitude, longitude)
+		if _, err := point.GetGridReference("OSTN15"); errors.Is(err, coordinates.ErrOSTN15Unavailable) {
+			return Response{}, err
+		}
 	}
 
 	countryCode := strings.ToUpper(r.Country)
+	api := NewApi(countryCode, latitude, longitude, Options{
+		Height:          height,
+		HeightReference: heightReference,
+		OSTN15:          ostn15,
+	})
+	_, isFallback := api.(*fallback.Fallback)
+
+	point := coordinates.NewWithHeight(latitude, longitude, ellipsoidHeight)
+	mgrs, _ := point.GetMGRS()
+	maidenhead, _ := point.GetMaidenhead(8)
+	geohash, _ := point.GetGeohash(9)
+
+	details := Details{
+		Country:          api.GetCountryName(),
+		Code:             countryCode,
+		Service:          api.GetServiceName(),
+		NationalDatabase: !isFallback,
+		Latitude:         latitude,
+		Longitude:        longitude,
+		Height:           height,
+		HeightReference:  heightReference,
+		MGRS:             mgrs,
+		Maidenhead:       maidenhead,
+		Geohash:          geohash,
+	}
+
+	channelInfo, err := api.Call()
+	details.Grid = nativeGrid(api, &point)
+	if err != nil {
+		return Response{
+			Status:   "Error",
+			Details:  details,
+			Channels: []channel.Channel{},
+		}, nil
+	}
+	return Response{
+		Status:   "OK",
+		Details:  details,
+		Channels: *channelInfo,
+	}, nil
+}
+
+// Options are the lookup settings passed on to the providers that use them.
+// Height is in metres above ground when HeightReference is "ground", or above
+// the WGS84 ellipsoid when it is "ellipsoid", and ignored otherwise. OSTN15
+// selects the OSTN15 transformation for the British National Grid.
+type Options struct {
+	Height          float64
+	HeightReference string
+	OSTN15          bool
+}
+
+// NewApi returns the provider for a country code, or the CEPT/ECC fallback
+// for countries without a national database.
+func NewApi(countryCode string, latitude float64, longitude float64, options Options) Api {
+	var groundHeight, ellipsoidHeight float64
+	var antennaHeightType string
+	switch options.HeightReference {
+	case "ground":
+		groundHeight = options.Height
+		antennaHeightType = "AGL"
+	case "ellipsoid":
+		ellipsoidHeight = options.Height
+	}
 
 	var api Api
 	switch countryCode {
@@ -84,43 +212,152 @@ func HandleLambdaEvent(ctx context.Context, r LambdaRequest) (Response, error) {
 		api = &se.Sweden{Latitude: latitude, Longitude: longitude}
 	case "DK":
 		api = &dk.Denmark{Latitude: latitude, Longitude: longitude}
+	case "FI":
+		api = &fi.Finland{Latitude: latitude, Longitude: longitude}
 	case "NO":
 		api = &no.Norway{Latitude: latitude, Longitude: longitude}
+	case "DE":
+		api = &de.Germany{Latitude: latitude, Longitude: longitude}
+	case "FR":
+		api = &fr.France{Latitude: latitude, Longitude: longitude, Height: groundHeight}
 	case "NL":
 		api = &nl.Netherlands{Latitude: latitude, Longitude: longitude}
+	case "BE":
+		api = &be.Belgium{Latitude: latitude, Longitude: longitude, EllipsoidHeight: ellipsoidHeight}
 	case "GB", "IM":
-		api = &gb.GB{Latitude: latitude, Longitude: longitude, Code: "GB"}
+		api = &gb.GB{Latitude: latitude, Longitude: longitude, EllipsoidHeight: ellipsoidHeight, Code: "GB", OSTN15: options.OSTN15}
 	case "NI":
-		api = &gb.GB{Latitude: latitude, Longitude: longitude, Code: "IE"}
+		api = &gb.GB{Latitude: latitude, Longitude: longitude, EllipsoidHeight: ellipsoidHeight, Code: "IE"}
 	case "JE", "GG":
-		api = &gb.GB{Latitude: latitude, Longitude: longitude, Code: "UTM"}
+		api = &gb.GB{Latitude: latitude, Longitude: longitude, EllipsoidHeight: ellipsoidHeight, Code: "UTM"}
+	case "AU":
+		api = &au.Australia{Latitude: latitude, Longitude: longitude}
+	case "US":
+		api = &us.UnitedStates{
+			Latitude:          latitude,
+			Longitude:         longitude,
+			AntennaHeight:     groundHeight,
+			AntennaHeightType: antennaHeightType,
+			Endpoint:          os.Getenv("PAWS_URL"),
+			SerialNumber:      os.Getenv("PAWS_SERIAL_NUMBER"),
+			FccId:             os.Getenv("PAWS_FCC_ID"),
+		}
 	default:
-		api = &unknown.Unknown{}
+		api = &fallback.Fallback{Code: countryCode}
 	}
+	return api
+}
 
-	channelInfo, err := api.Call()
+// parseLocation reads the location from whichever of the request's location
+// fields is set, and checks it is a valid latitude and longitude however it
+// was given.
+func parseLocation(r LambdaRequest) (float64, float64, error) {
+	point, err := parsePoint(r)
 	if err != nil {
-		return Response{
-			Status: "Error",
-			Details: Details{
-				Country:   api.GetCountryName(),
-				Code:      countryCode,
-				Service:   api.GetServiceName(),
-				Latitude:  latitude,
-				Longitude: longitude,
-			},
-			Channels: []channel.Channel{},
-		}, nil
+		return 0, 0, err
+	}
+	if err := point.Validate(); err != nil {
+		return 0, 0, err
+	}
+	return point.GetLatitude(), point.GetLongitude(), nil
+}
+
+func parsePoint(r LambdaRequest) (coordinates.Point, error) {
+	if r.Location != "" {
+		point, err := coordinates.Parse(r.Location)
+		if err != nil {
+			return coordinates.Point{}, errors.New("location must be a valid coordinate or grid reference")
+		}
+		return point, nil
+	}
+
+	if r.Maidenhead != "" {
+		point, err := coordinates.ParseMaidenhead(r.Maidenhead)
+		if err != nil {
+			return coordinates.Point{}, errors.New("maidenhead must be a valid locator")
+		}
+		return point, nil
+	}
+
+	if r.Geohash != "" {
+		point, err := coordinates.ParseGeohash(r.Geohash)
+		if err != nil {
+			return coordinates.Point{}, errors.New("geohash must be a valid geohash")
+		}
+		return point, nil
+	}
+
+	if r.GridReference != "" {
+		point, err := coordinates.NewFromGridReference(r.GridReference)
+		if err != nil {
+			return coordinates.Point{}, errors.New("gridReference must be a valid grid reference")
+		}
+		return point, nil
+	}
+
+	latitude, err := strconv.ParseFloat(r.Latitude, 64)
+	if err != nil || math.IsNaN(latitude) || math.IsInf(latitude, 0) {
+		return coordinates.Point{}, errors.New("latitude must be a number")
+	}
+	longitude, err := strconv.ParseFloat(r.Longitude, 64)
+	if err != nil || math.IsNaN(longitude) || math.IsInf(longitude, 0) {
+		return coordinates.Point{}, errors.New("longitude must be a number")
+	}
+	return coordinates.New(latitude, longitude), nil
+}
+
+func nativeGrid(api Api, point *coordinates.Point) *Grid {
+	gridApi, ok := api.(GridApi)
+	if !ok {
+		return nil
+	}
+
+	gridReference, err := point.GetGridReference(gridApi.GetGridSystem())
+	if err != nil {
+		return nil
+	}
+
+	grid := &Grid{
+		System:   gridReference.GetGridSystem(),
+		Easting:  gridReference.GetEasting(),
+		Northing: gridReference.GetNorthing(),
+		Code:     gridReference.GetCode(),
+	}
+	switch grid.System {
+	case "UTM", "ETRS89UTM", "MGA":
+		grid.Zone = gridReference.GetZone()
+	}
+	if coordinatesApi, ok := api.(GridCoordinatesApi); ok {
+		if easting, northing, ok := coordinatesApi.GetGridCoordinates(); ok {
+			grid.Easting = easting
+			grid.Northing = northing
+		}
+	}
+	return grid
+}
+
+// parseHeight returns the requested height and what it is measured from,
+// either "ground" or "ellipsoid", or an empty reference when no height was
+// given.
+func parseHeight(r LambdaRequest) (float64, string, error) {
+	if r.Height == "" {
+		return 0, "", nil
+	}
+
+	height, err := strconv.ParseFloat(r.Height, 64)
+	if err != nil || math.IsNaN(height) || math.IsInf(height, 0) {
+		return 0, "", errors.New("height must be a number")
+	}
+	if height < -1000 || height > 10000 {
+		return 0, "", errors.New("height must be between -1000 and 10000 metres")
+	}
+
+	switch strings.ToLower(r.HeightReference) {
+	case "", "ground":
+		return height, "ground", nil
+	case "ellipsoid":
+		return height, "ellipsoid", nil
+	default:
+		return 0, "", errors.New("heightReference must be ground or ellipsoid")
 	}
-	return Response{
-		Status: "OK",
-		Details: Details{
-			Country:   api.GetCountryName(),
-			Code:      countryCode,
-			Service:   api.GetServiceName(),
-			Latitude:  latitude,
-			Longitude: longitude,
-		},
-		Channels: *channelInfo,
-	}, nil
 }
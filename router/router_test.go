@@ -0,0 +1,48 @@
+package router_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stebunting/rfxp-backend/coordinates"
+	"github.com/stebunting/rfxp-backend/router"
+)
+
+func TestInvalidRequests(t *testing.T) {
+	type TestCases struct {
+		Name    string
+		Request router.LambdaRequest
+	}
+	testCases := []TestCases{
+		{Name: "NaN Height", Request: router.LambdaRequest{Country: "XX", Latitude: "59", Longitude: "18", Height: "NaN"}},
+		{Name: "Inf Height", Request: router.LambdaRequest{Country: "XX", Latitude: "59", Longitude: "18", Height: "+Inf"}},
+		{Name: "High Height", Request: router.LambdaRequest{Country: "XX", Latitude: "59", Longitude: "18", Height: "10001"}},
+		{Name: "Height Reference", Request: router.LambdaRequest{Country: "XX", Latitude: "59", Longitude: "18", Height: "2", HeightReference: "sea"}},
+		{Name: "NaN Latitude", Request: router.LambdaRequest{Country: "XX", Latitude: "NaN", Longitude: "18"}},
+		{Name: "Inf Longitude", Request: router.LambdaRequest{Country: "XX", Latitude: "59", Longitude: "-Inf"}},
+		{Name: "High Latitude", Request: router.LambdaRequest{Country: "XX", Latitude: "91", Longitude: "18"}},
+		{Name: "Wide Longitude", Request: router.LambdaRequest{Country: "XX", Latitude: "59", Longitude: "180.5"}},
+		{Name: "UTM Northing", Request: router.LambdaRequest{Country: "XX", Location: "33V 500000 99999999"}},
+		{Name: "UTM Band", Request: router.LambdaRequest{Country: "XX", Location: "33U 674031 6580807"}},
+		{Name: "Transformation", Request: router.LambdaRequest{Country: "GB", Latitude: "51", Longitude: "0", Transformation: "ostn02"}},
+	}
+
+	for _, test := range testCases {
+		if _, err := router.HandleLambdaEvent(context.Background(), test.Request); err == nil {
+			t.Fatalf("\n--- Expected Error ---\n    NAME: %s", test.Name)
+		}
+	}
+}
+
+func TestOSTN15Unavailable(t *testing.T) {
+	if os.Getenv("OSTN15_DATA") != "" {
+		t.Skip("OSTN15 data available")
+	}
+
+	request := router.LambdaRequest{Country: "GB", Latitude: "51.4276", Longitude: "-0.1908", Transformation: "ostn15"}
+	if _, err := router.HandleLambdaEvent(context.Background(), request); !errors.Is(err, coordinates.ErrOSTN15Unavailable) {
+		t.Fatalf("\n--- Expected Error ---\n    NAME: %s", "OSTN15 Unavailable")
+	}
+}